@@ -7,7 +7,6 @@ import (
 	"crypto/sha256"
 	"crypto/x509"
 	"encoding/pem"
-	"errors"
 	"fmt"
 )
 
@@ -20,7 +19,7 @@ func (mc *mysqlConn) auth(authData []byte, plugin string) ([]byte, error) {
 		authResp := scramblePassword(authData[:20], mc.cfg.Passwd)
 		return authResp, nil
 	default:
-		return nil, errors.New("this authentication plugin is not supported")
+		return nil, ErrUnknownPlugin
 	}
 }
 
@@ -127,10 +126,10 @@ func (mc *mysqlConn) handleAuthResult(oldAuthData []byte, plugin string) error {
 				}
 				return mc.resultUnchanged().readResultOK()
 			default:
-				return errors.New("malformed packet")
+				return ErrMalformPkt
 			}
 		default:
-			return errors.New("malformed packet")
+			return ErrMalformPkt
 		}
 	default:
 		return nil
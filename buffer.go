@@ -1,7 +1,6 @@
 package mysqldriver
 
 import (
-	"errors"
 	"io"
 )
 
@@ -45,7 +44,7 @@ func (b *buffer) fill(need int, r readerFunc) error {
 	for {
 		nn, err := r(dest[n:])
 		n += nn
-		if err != nil && n < need {
+		if err == nil && n < need {
 			continue
 		}
 		b.buf = dest[:n]
@@ -73,7 +72,7 @@ func (b *buffer) readNext(need int, r readerFunc) ([]byte, error) {
 
 func (b *buffer) takeBuffer(length int) ([]byte, error) {
 	if b.busy() {
-		return nil, errors.New("busy buffer")
+		return nil, ErrBusyBuffer
 	}
 	if length <= len(b.cachedBuf) {
 		return b.cachedBuf[:length], nil
@@ -87,13 +86,13 @@ func (b *buffer) takeBuffer(length int) ([]byte, error) {
 
 func (b *buffer) takeSmallBuffer(length int) ([]byte, error) {
 	if b.busy() {
-		return nil, errors.New("busy buffer")
+		return nil, ErrBusyBuffer
 	}
 	return b.cachedBuf[:length], nil
 }
 func (b *buffer) takeCompleteBuffer() ([]byte, error) {
 	if b.busy() {
-		return nil, errors.New("busy buffer")
+		return nil, ErrBusyBuffer
 	}
 	return b.cachedBuf, nil
 }
@@ -0,0 +1,44 @@
+package mysqldriver
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+// chunkedReader returns the chunks in reads, one per call, then err.
+func chunkedReader(err error, reads ...string) readerFunc {
+	return func(b []byte) (int, error) {
+		if len(reads) == 0 {
+			return 0, err
+		}
+		n := copy(b, reads[0])
+		reads = reads[1:]
+		return n, nil
+	}
+}
+
+func TestBufferReadNext(t *testing.T) {
+	errRead := errors.New("read failed")
+	tests := []struct {
+		name    string
+		r       readerFunc
+		want    string
+		wantErr error
+	}{
+		{"short reads", chunkedReader(nil, "ab", "c", "def"), "abcdef", nil},
+		{"EOF after the data", chunkedReader(io.EOF, "abc", "def"), "abcdef", nil},
+		// a failing read must end the fill instead of being retried
+		{"error", chunkedReader(errRead, "abc"), "", errRead},
+		{"EOF before the data", chunkedReader(io.EOF, "abc"), "", io.ErrUnexpectedEOF},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newBuffer()
+			data, err := b.readNext(6, tt.r)
+			if err != tt.wantErr || string(data) != tt.want {
+				t.Errorf("got %q, %v, want %q, %v", data, err, tt.want, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package mysqldriver
+
+import (
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+)
+
+// charsetEncodings maps the MySQL character sets which are not compatible
+// with UTF-8 to their encodings. They are only used with decodeCharsets=true.
+var charsetEncodings = map[string]encoding.Encoding{
+	"big5":     traditionalchinese.Big5,
+	"cp1250":   charmap.Windows1250,
+	"cp1251":   charmap.Windows1251,
+	"cp1256":   charmap.Windows1256,
+	"cp1257":   charmap.Windows1257,
+	"cp850":    charmap.CodePage850,
+	"cp852":    charmap.CodePage852,
+	"cp866":    charmap.CodePage866,
+	"cp932":    japanese.ShiftJIS, // x/text implements the Windows-31J variant
+	"eucjpms":  japanese.EUCJP,
+	"euckr":    korean.EUCKR,
+	"gb18030":  simplifiedchinese.GB18030,
+	"gb2312":   simplifiedchinese.GBK,
+	"gbk":      simplifiedchinese.GBK,
+	"greek":    charmap.ISO8859_7,
+	"hebrew":   charmap.ISO8859_8,
+	"koi8r":    charmap.KOI8R,
+	"koi8u":    charmap.KOI8U,
+	"latin1":   charmap.Windows1252, // MySQL's latin1 is cp1252
+	"latin2":   charmap.ISO8859_2,
+	"latin5":   charmap.ISO8859_9,
+	"latin7":   charmap.ISO8859_13,
+	"macroman": charmap.Macintosh,
+	"sjis":     japanese.ShiftJIS,
+	"tis620":   charmap.Windows874,
+	"ujis":     japanese.EUCJP,
+}
+
+// collationDecoder returns a decoder to UTF-8 for values in the given
+// collation, or nil if they need no transcoding.
+func collationDecoder(id uint16) *encoding.Decoder {
+	if id == binaryCollationID {
+		return nil
+	}
+	if enc, ok := charsetEncodings[collationCharset(collationNames[id])]; ok {
+		return enc.NewDecoder()
+	}
+	return nil
+}
+
+// charsetEncoder returns an encoder from UTF-8 to the given character set,
+// or nil if strings can be sent unchanged.
+func charsetEncoder(charset string) *encoding.Encoder {
+	if enc, ok := charsetEncodings[charset]; ok {
+		return enc.NewEncoder()
+	}
+	return nil
+}
+
+// isTextField reports whether values of the field are character strings
+// which are sent in the character set of the column.
+func (mf *mysqlField) isTextField() bool {
+	switch mf.fieldType {
+	case fieldTypeVarChar, fieldTypeVarString, fieldTypeString,
+		fieldTypeTinyBLOB, fieldTypeMediumBLOB, fieldTypeLongBLOB,
+		fieldTypeBLOB, fieldTypeEnum, fieldTypeSet:
+		return mf.charSet != binaryCollationID
+	}
+	return false
+}
@@ -0,0 +1,110 @@
+package mysqldriver
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"testing"
+)
+
+var charsetTests = []struct {
+	charset   string
+	collation uint16
+	utf8      string
+	encoded   string
+}{
+	{"latin1", 8, "café €", "caf\xe9 \x80"}, // MySQL's latin1 is cp1252
+	{"sjis", 13, "日本語", "\x93\xfa\x96\x7b\x8c\xea"},
+}
+
+// newCharsetConn returns a connection in charset with decodeCharsets=true.
+func newCharsetConn(charset string, responses ...[]byte) *mysqlConn {
+	mc := newMockConnData(responses...)
+	mc.cfg.DecodeCharsets = true
+	mc.charset = charset
+	mc.encoder = charsetEncoder(charset)
+	return mc
+}
+
+func TestCharsetTextProtocol(t *testing.T) {
+	for _, tt := range charsetTests {
+		t.Run(tt.charset, func(t *testing.T) {
+			mc := newCharsetConn(tt.charset, mockResponse(1,
+				[]byte{1},
+				mockColumn("v", fieldTypeVarString, 0, tt.collation),
+				mockEOF(0),
+				mockTextRow(tt.encoded),
+				mockEOF(0),
+			))
+
+			rows, err := mc.query("SELECT '"+tt.utf8+"'", nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			wantCommands(t, mc, []byte("\x03SELECT '"+tt.encoded+"'"))
+
+			dest := make([]driver.Value, 1)
+			if err := rows.Next(dest); err != nil {
+				t.Fatal(err)
+			}
+			if dest[0] != tt.utf8 {
+				t.Errorf("got %q, want %q", dest[0], tt.utf8)
+			}
+		})
+	}
+}
+
+func TestCharsetBinaryProtocol(t *testing.T) {
+	for _, tt := range charsetTests {
+		t.Run(tt.charset, func(t *testing.T) {
+			row := appendLengthEncodedString([]byte{iOK, 0x00}, tt.encoded)
+			mc := newCharsetConn(tt.charset, mockResponse(1,
+				[]byte{1},
+				mockColumn("v", fieldTypeVarString, 0, tt.collation),
+				mockEOF(0),
+				row,
+				mockEOF(0),
+			))
+
+			stmt := &mysqlStmt{mc: mc, id: 1, paramCount: 1}
+			rows, err := stmt.query([]driver.Value{tt.utf8}, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			// the parameter is the last value of COM_STMT_EXECUTE
+			pkts := mc.netConn.(*mockConn).packets()
+			if want := appendLengthEncodedString(nil, tt.encoded); len(pkts) != 1 || !bytes.HasSuffix(pkts[0], want) {
+				t.Errorf("sent %x, want the parameter %x", pkts, want)
+			}
+
+			dest := make([]driver.Value, 1)
+			if err := rows.Next(dest); err != nil {
+				t.Fatal(err)
+			}
+			if dest[0] != tt.utf8 {
+				t.Errorf("got %q, want %q", dest[0], tt.utf8)
+			}
+		})
+	}
+}
+
+func TestCharsetBinaryColumn(t *testing.T) {
+	// values of binary columns are never transcoded
+	mc := newCharsetConn("sjis", mockResponse(1,
+		[]byte{1},
+		mockColumn("v", fieldTypeBLOB, flagBinary, binaryCollationID),
+		mockEOF(0),
+		mockTextRow("\x93\xfa"),
+		mockEOF(0),
+	))
+	rows, err := mc.query("SELECT v FROM t", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dest := make([]driver.Value, 1)
+	if err := rows.Next(dest); err != nil {
+		t.Fatal(err)
+	}
+	if b, ok := dest[0].([]byte); !ok || string(b) != "\x93\xfa" {
+		t.Errorf("got %q, want the raw bytes", dest[0])
+	}
+}
@@ -4,8 +4,18 @@ import (
 	"context"
 	"database/sql/driver"
 	"errors"
+	"fmt"
+	"io"
+	"maps"
 	"net"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
 	"sync/atomic"
+	"time"
+
+	"golang.org/x/text/encoding"
 )
 
 type mysqlConn struct {
@@ -13,18 +23,32 @@ type mysqlConn struct {
 	netConn          net.Conn
 	rawConn          net.Conn
 	result           mysqlResult
+	session          SessionState
+	stmtCache        *stmtCache
 	cfg              *Config
 	connector        *connector
 	maxAllowedPacket int
+	flags            clientFlag // capabilities in effect, the intersection of the client's and the server's
+	server           ServerInfo
+	serverCollation  uint8
+	charset          string            // character set of the connection
+	encoder          *encoding.Encoder // from UTF-8 to charset, only set with decodeCharsets=true
 	status           statusFlag
 	sequence         uint8
 
-	watching bool
-	watcher  chan<- context.Context
-	closech  chan struct{}
-	finished chan<- struct{}
-	canceled atomicError
-	closed   atomic.Bool
+	watching    bool
+	deadlineSet bool            // the deadline of a context is set on netConn
+	watchDone   <-chan struct{} // Done channel of the context with a registered AfterFunc
+	stopWatch   func() bool     // stops the AfterFunc for watchDone
+	watchMu     sync.Mutex      // held by the AfterFunc while it cancels the command
+	activeDone  <-chan struct{} // Done channel of the running command's context, guarded by watchMu
+	finished    chan struct{}   // tells killQuery that the interrupted command finished
+	canceled    atomicError
+	closed      atomic.Bool
+
+	// set while a query is interrupted with KILL QUERY
+	killed    atomic.Bool
+	killedErr atomicError
 }
 
 func (mc *mysqlConn) writeWithTimeout(b []byte) (int, error) {
@@ -38,29 +62,195 @@ func (mc *mysqlConn) Prepare(query string) (driver.Stmt, error) {
 	if mc.closed.Load() {
 		return nil, driver.ErrBadConn
 	}
+	if mc.stmtCache != nil {
+		if stmt := mc.stmtCache.get(query); stmt != nil {
+			return stmt, nil
+		}
+	}
+
+	stmt, err := mc.prepare(query)
+	if err == nil && mc.stmtCache != nil {
+		mc.stmtCache.put(stmt)
+	}
+	return stmt, err
+}
+
+func (mc *mysqlConn) prepare(query string) (*mysqlStmt, error) {
 	err := mc.writeCommandPacketStr(comStmtPrepare, query)
 	if err != nil {
-		return nil, driver.ErrBadConn
+		return nil, mc.markBadConn(err)
 	}
 
 	stmt := &mysqlStmt{
-		mc: mc,
+		mc:  mc,
+		sql: query,
 	}
 
 	columnCount, err := stmt.readPrepareResultPacket()
 	if err == nil {
 		if stmt.paramCount > 0 {
-			if err = mc.readUntilEOF(); err != nil {
+			if err = mc.skipColumns(stmt.paramCount); err != nil {
 				return nil, err
 			}
-			if columnCount > 0 {
-				err = mc.readUntilEOF()
-			}
+		}
+		if columnCount > 0 {
+			err = mc.skipColumns(int(columnCount))
 		}
 	}
 	return stmt, err
 }
 
+// handleParams applies the connection settings of the DSN that can't be
+// negotiated in the handshake.
+func (mc *mysqlConn) handleParams() (err error) {
+	collation := mc.cfg.Collation
+
+	// charset=a,b: use the first charset the server accepts
+	if len(mc.cfg.charsets) > 0 {
+		for _, charset := range mc.cfg.charsets {
+			query := "SET NAMES " + charset
+			if collation != "" && collationCharset(collation) == charset {
+				query += " COLLATE " + collation
+			}
+			// ignore errors here - a charset may not exist
+			if err = mc.exec(query); err == nil {
+				mc.charset = charset
+				break
+			}
+		}
+		if err != nil {
+			return err
+		}
+	} else if collations[collation] > 0xff {
+		// the collation could not be sent in the handshake
+		if err = mc.exec("SET NAMES " + collationCharset(collation) + " COLLATE " + collation); err != nil {
+			return err
+		}
+	}
+
+	if mc.cfg.DecodeCharsets {
+		mc.encoder = charsetEncoder(mc.charset)
+	}
+
+	// system variables, all in one round trip. The values are SQL written
+	// as is, e.g. quoted strings or expressions.
+	if len(mc.cfg.Params) > 0 {
+		var cmdSet strings.Builder
+		cmdSet.WriteString("SET ")
+		for i, param := range slices.Sorted(maps.Keys(mc.cfg.Params)) {
+			if i > 0 {
+				cmdSet.WriteString(", ")
+			}
+			cmdSet.WriteString(param)
+			cmdSet.WriteString(" = ")
+			cmdSet.WriteString(mc.cfg.Params[param])
+		}
+		if err = mc.exec(cmdSet.String()); err != nil {
+			return err
+		}
+	}
+
+	for _, cmd := range mc.cfg.InitCommands {
+		if err = mc.exec(cmd); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deadlineQuery returns query with the time left until the deadline of ctx
+// as execution time limit if deadlineToServer is enabled, so the server
+// aborts the query itself when the deadline passes. Only SELECT statements
+// are limited: with the MAX_EXECUTION_TIME optimizer hint on MySQL, which
+// ignores it unless the statement is read-only, and with SET STATEMENT
+// max_statement_time on MariaDB. Other queries are sent unchanged.
+func (mc *mysqlConn) deadlineQuery(ctx context.Context, query string) string {
+	if !mc.cfg.DeadlineToServer {
+		return query
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return query
+	}
+	ms := time.Until(deadline).Milliseconds()
+	if ms <= 0 {
+		return query
+	}
+	i := selectEnd(query)
+	if i < 0 {
+		return query
+	}
+
+	switch mc.server.Flavor {
+	case FlavorMariaDB:
+		if !mc.server.AtLeast(10, 1, 2) {
+			return query
+		}
+		return fmt.Sprintf("SET STATEMENT max_statement_time=%d.%03d FOR %s", ms/1000, ms%1000, query)
+	default:
+		if !mc.server.AtLeast(5, 7, 8) {
+			return query
+		}
+		// a statement takes a single hint comment
+		if strings.HasPrefix(strings.TrimLeft(query[i:], " \t\r\n"), "/*+") {
+			return query
+		}
+		return query[:i] + " /*+ MAX_EXECUTION_TIME(" + strconv.FormatInt(ms, 10) + ") */" + query[i:]
+	}
+}
+
+// selectEnd returns the offset following the SELECT keyword query starts
+// with, or -1 if query isn't a SELECT statement.
+func selectEnd(query string) int {
+	rest := strings.TrimLeft(query, " \t\r\n")
+	if len(rest) < 7 || !strings.EqualFold(rest[:6], "SELECT") || isIdentChar(rest[6]) {
+		return -1
+	}
+	return len(query) - len(rest) + 6
+}
+
+func isIdentChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_' || c == '$'
+}
+
+// getSystemVar returns the value of the given system variable.
+func (mc *mysqlConn) getSystemVar(name string) (string, error) {
+	handleOk := mc.clearResult()
+	if err := mc.writeCommandPacketStr(comQuery, "SELECT @@"+name); err != nil {
+		return "", mc.markBadConn(err)
+	}
+
+	resLen, err := handleOk.readResultSetHeaderPacket()
+	if err != nil {
+		return "", err
+	}
+	if resLen != 1 {
+		return "", fmt.Errorf("unexpected column count %d reading @@%s", resLen, name)
+	}
+	if err := mc.skipColumns(resLen); err != nil {
+		return "", err
+	}
+
+	rows := &textRows{mysqlRows{mc: mc}}
+	rows.rs.columns = []mysqlField{{fieldType: fieldTypeVarChar}}
+	dest := make([]driver.Value, resLen)
+	if err := rows.readRow(dest); err != nil {
+		return "", err
+	}
+	// copy the value, the next read reuses the buffer
+	var val string
+	switch v := dest[0].(type) {
+	case []byte:
+		val = string(v)
+	case string:
+		val = v
+	}
+	if err := mc.readUntilEOF(); err != nil {
+		return "", err
+	}
+	return val, handleOk.discardResults()
+}
+
 func (mc *mysqlConn) Exec(query string, args []driver.Value) (driver.Result, error) {
 	if mc.closed.Load() {
 		return nil, driver.ErrBadConn
@@ -92,7 +282,7 @@ func (mc *mysqlConn) exec(query string) error {
 	}
 	if resLen > 0 {
 		// columns
-		if err := mc.readUntilEOF(); err != nil {
+		if err := mc.skipColumns(resLen); err != nil {
 			return err
 		}
 		// rows
@@ -113,7 +303,11 @@ func (mc *mysqlConn) ExecContext(ctx context.Context, query string, args []drive
 	}
 	defer mc.finish()
 
-	return mc.Exec(query, dargs)
+	res, err := mc.Exec(mc.deadlineQuery(ctx, query), dargs)
+	if err != nil {
+		return nil, err
+	}
+	return mc.withWarnings(res.(*mysqlResult), fetchWarningsFromContext(ctx, mc.cfg.FetchWarnings))
 }
 
 func (mc *mysqlConn) Begin() (driver.Tx, error) {
@@ -147,12 +341,13 @@ func (mc *mysqlConn) error() error {
 		if err := mc.canceled.Value(); err != nil {
 			return err
 		}
-		return errors.New("invalid connection")
+		return ErrInvalidConn
 	}
 	return nil
 }
 
 func (mc *mysqlConn) watchCancel(ctx context.Context) error {
+	mc.killed.Store(false)
 	if mc.watching {
 		mc.cleanup()
 		return nil
@@ -160,56 +355,94 @@ func (mc *mysqlConn) watchCancel(ctx context.Context) error {
 	if err := ctx.Err(); err != nil {
 		return err
 	}
+	if deadline, ok := ctx.Deadline(); ok && mc.netConn != nil {
+		if mc.cfg.KillQueryOnCancel {
+			// leave time to kill the query, the deadline only closes the
+			// connection if that fails
+			deadline = deadline.Add(killQueryTimeout)
+		}
+		if err := mc.netConn.SetDeadline(deadline); err != nil {
+			return err
+		}
+		mc.deadlineSet = true
+	}
 	if ctx.Done() == nil {
 		return nil
 	}
-	if mc.watcher == nil {
-		return nil
+
+	// Commands often share a context, e.g. the one of an HTTP request, or
+	// use contexts derived with context.WithValue, which are done at the
+	// same time. The AfterFunc stays registered for them.
+	done := ctx.Done()
+	if done != mc.watchDone {
+		if mc.stopWatch != nil {
+			// if it already runs, it finds no command of its context
+			mc.stopWatch()
+		}
+		mc.watchDone = done
+		mc.stopWatch = context.AfterFunc(ctx, func() { mc.contextDone(ctx) })
 	}
 
+	mc.watchMu.Lock()
+	mc.activeDone = done
+	mc.watchMu.Unlock()
 	mc.watching = true
-	mc.watcher <- ctx
+
+	// the AfterFunc may have run before the command was set active
+	if err := ctx.Err(); err != nil {
+		mc.finish()
+		return err
+	}
 	return nil
 }
 
-func (mc *mysqlConn) startWatcher() {
-	watcher := make(chan context.Context, 1)
-	mc.watcher = watcher
-	finished := make(chan struct{})
-	mc.finished = finished
-	go func() {
-		for {
-			var ctx context.Context
-			select {
-			case ctx = <-watcher:
-			case <-mc.closech:
-				return
-			}
-
-			select {
-			case <-ctx.Done():
-				mc.cancel(ctx.Err())
-			case <-finished:
-			case <-mc.closech:
-				return
-			}
-		}
-	}()
+// contextDone runs in its own goroutine when ctx is done. It cancels the
+// command running with ctx, if any.
+func (mc *mysqlConn) contextDone(ctx context.Context) {
+	mc.watchMu.Lock()
+	defer mc.watchMu.Unlock()
+	if mc.activeDone != ctx.Done() {
+		return
+	}
+	if mc.cfg.KillQueryOnCancel {
+		mc.killQuery(ctx.Err())
+	} else {
+		mc.cancel(ctx.Err())
+	}
 }
 
 func (mc *mysqlConn) CheckNamedValue(nv *driver.NamedValue) (err error) {
+	// readers are streamed to the server with COM_STMT_SEND_LONG_DATA
+	if _, ok := nv.Value.(driver.Valuer); !ok {
+		if _, ok := nv.Value.(io.Reader); ok {
+			return nil
+		}
+	}
 	nv.Value, err = converter{}.ConvertValue(nv.Value)
 	return
 }
 
 func (mc *mysqlConn) finish() {
-	if !mc.watching || mc.finished == nil {
-		return
-	}
-	select {
-	case mc.finished <- struct{}{}:
+	if mc.watching {
 		mc.watching = false
-	case <-mc.closech:
+		// Let a killQuery waiting for the command return. It holds watchMu
+		// until the KILL is done, so it can't hit the next command.
+		select {
+		case mc.finished <- struct{}{}:
+		default:
+		}
+		mc.watchMu.Lock()
+		mc.activeDone = nil
+		mc.watchMu.Unlock()
+		select {
+		case <-mc.finished:
+		default:
+		}
+	}
+	if mc.deadlineSet {
+		mc.deadlineSet = false
+		// ignore the error, it is reported by the next read or write
+		_ = mc.netConn.SetDeadline(time.Time{})
 	}
 }
 
@@ -218,6 +451,31 @@ func (mc *mysqlConn) cancel(err error) {
 	mc.cleanup()
 }
 
+// killQuery interrupts the running command with KILL QUERY, sent over a
+// side connection, so that mc stays usable. The command then fails with
+// err. If the query can't be killed or mc doesn't finish the command in
+// time, mc is closed like by cancel.
+func (mc *mysqlConn) killQuery(err error) {
+	mc.killedErr.Set(err)
+	mc.killed.Store(true)
+
+	// Don't give up on the kill when the command finishes meanwhile: finish
+	// waits until the KILL is done, so it can't hit the next command.
+	ctx, cancel := context.WithTimeout(context.Background(), killQueryTimeout)
+	defer cancel()
+	if kerr := mc.connector.kill(ctx, mc.server.ThreadID); kerr != nil {
+		mc.cancel(err)
+		return
+	}
+
+	// wait for the interrupted command to read its error
+	select {
+	case <-mc.finished:
+	case <-ctx.Done():
+		mc.cancel(err)
+	}
+}
+
 // Ping implements driver.Pinger interface
 func (mc *mysqlConn) Ping(ctx context.Context) (err error) {
 
@@ -237,8 +495,16 @@ func (mc *mysqlConn) resetSequence() {
 	mc.sequence = 0
 }
 
+// markBadConn returns driver.ErrBadConn, wrapping the cause, if nothing of
+// the failed command was written, so database/sql can safely retry it on
+// another connection. Any other error is returned as is, as retrying a
+// command the server may have executed is not safe.
 func (mc *mysqlConn) markBadConn(err error) error {
-	return errors.New("driver: bad connection")
+	var nwErr *noWriteError
+	if errors.As(err, &nwErr) {
+		return fmt.Errorf("%w: %w", driver.ErrBadConn, nwErr.err)
+	}
+	return err
 }
 
 func (mc *mysqlConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
@@ -251,12 +517,13 @@ func (mc *mysqlConn) QueryContext(ctx context.Context, query string, args []driv
 		return nil, err
 	}
 
-	rows, err := mc.query(query, dargs)
+	rows, err := mc.query(mc.deadlineQuery(ctx, query), dargs)
 	if err != nil {
 		mc.finish()
 		return nil, err
 	}
 	rows.finish = mc.finish
+	rows.fetchWarnings = fetchWarningsFromContext(ctx, mc.cfg.FetchWarnings)
 	return rows, err
 }
 
@@ -290,7 +557,14 @@ func (mc *mysqlConn) query(query string, args []driver.Value) (*textRows, error)
 	rows.mc = mc
 
 	if resLen == 0 {
-		// TODO: handle empty result set
+		rows.rs.done = true
+
+		switch err := rows.NextResultSet(); err {
+		case nil, io.EOF:
+			return rows, nil
+		default:
+			return nil, err
+		}
 	}
 	rows.rs.columns, err = mc.readColumns(resLen)
 	return rows, err
@@ -303,38 +577,34 @@ func (mc *mysqlConn) syncSequence() {
 }
 
 func (mc *mysqlConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if mc.closed.Load() {
+		return nil, driver.ErrBadConn
+	}
 	if err := mc.watchCancel(ctx); err != nil {
 		return nil, err
 	}
-	stmt, err := mc.Prepare(query)
+	if mc.stmtCache != nil {
+		if stmt := mc.stmtCache.get(query); stmt != nil {
+			mc.finish()
+			return stmt, nil
+		}
+	}
+	stmt, err := mc.prepare(query)
 	mc.finish()
 
 	if err != nil {
 		return nil, err
 	}
 
-	select {
-	default:
-	case <-ctx.Done():
+	// The context was canceled while the statement was prepared, but the
+	// connection survived, e.g. because the query was killed instead. Close
+	// the statement on the server before it is cached.
+	if err := ctx.Err(); err != nil {
 		stmt.Close()
-		return nil, ctx.Err()
-	}
-	return stmt, nil
-}
-
-func (stmt *mysqlStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
-	dargs, err := namedValueToValue(args)
-	if err != nil {
 		return nil, err
 	}
-	if err := stmt.mc.watchCancel(ctx); err != nil {
-		return nil, err
+	if mc.stmtCache != nil {
+		mc.stmtCache.put(stmt)
 	}
-	rows, err := stmt.query(dargs)
-	if err != nil {
-		stmt.mc.finish()
-		return nil, err
-	}
-	rows.finish = stmt.mc.finish
-	return rows, err
+	return stmt, nil
 }
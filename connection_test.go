@@ -0,0 +1,432 @@
+package mysqldriver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestDeadlineQuery(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 90*time.Second)
+	defer cancel()
+
+	tests := []struct {
+		version string
+		query   string
+		want    string // regular expression
+	}{
+		{"8.0.36", "SELECT * FROM t", `^SELECT /\*\+ MAX_EXECUTION_TIME\(\d{5}\) \*/ \* FROM t$`},
+		{"8.0.36", "\n  select 1", `^\n  select /\*\+ MAX_EXECUTION_TIME\(\d{5}\) \*/ 1$`},
+		{"8.0.36", "SELECT /*+ NO_INDEX_MERGE(t) */ * FROM t", `^SELECT /\*\+ NO_INDEX_MERGE\(t\) \*/ \* FROM t$`},
+		{"8.0.36", "UPDATE t SET a = 1", `^UPDATE t SET a = 1$`},
+		{"8.0.36", "SELECTION", `^SELECTION$`},
+		{"5.6.51", "SELECT 1", `^SELECT 1$`},
+		{"5.7.7", "SELECT 1", `^SELECT 1$`},
+		{"5.7.8", "SELECT 1", `^SELECT /\*\+ MAX_EXECUTION_TIME\(\d{5}\) \*/ 1$`},
+		{"8.0.11-TiDB-v7.5.0", "SELECT 1", `^SELECT /\*\+ MAX_EXECUTION_TIME\(\d{5}\) \*/ 1$`},
+		{"11.4.2-MariaDB", "SELECT * FROM t", `^SET STATEMENT max_statement_time=\d{2}\.\d{3} FOR SELECT \* FROM t$`},
+		{"11.4.2-MariaDB", " select /*+ hint */ 1", `^SET STATEMENT max_statement_time=\d{2}\.\d{3} FOR  select /\*\+ hint \*/ 1$`},
+		// only SELECT statements are limited, like on MySQL
+		{"11.4.2-MariaDB", "UPDATE t SET a = 1", `^UPDATE t SET a = 1$`},
+		{"11.4.2-MariaDB", "SELECTION", `^SELECTION$`},
+		{"5.5.5-10.1.1-MariaDB", "SELECT 1", `^SELECT 1$`},
+		{"5.5.5-10.1.2-MariaDB", "SELECT 1", `^SET STATEMENT max_statement_time=\d{2}\.\d{3} FOR SELECT 1$`},
+		{"5.5.5-10.0.38-MariaDB", "SELECT 1", `^SELECT 1$`},
+	}
+	for _, tst := range tests {
+		mc := &mysqlConn{cfg: &Config{DeadlineToServer: true}}
+		mc.server.parseServerVersion(tst.version)
+		if got := mc.deadlineQuery(ctx, tst.query); !regexp.MustCompile(tst.want).MatchString(got) {
+			t.Errorf("%s: deadlineQuery(%q) = %q, want %s", tst.version, tst.query, got, tst.want)
+		}
+	}
+
+	mc := &mysqlConn{cfg: &Config{DeadlineToServer: true}}
+	mc.server.parseServerVersion("8.0.36")
+	if got := mc.deadlineQuery(context.Background(), "SELECT 1"); got != "SELECT 1" {
+		t.Errorf("without deadline: got %q", got)
+	}
+	mc.cfg.DeadlineToServer = false
+	if got := mc.deadlineQuery(ctx, "SELECT 1"); got != "SELECT 1" {
+		t.Errorf("deadlineToServer=false: got %q", got)
+	}
+}
+
+func TestContextDeadlineOnSocket(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+	mc := &mysqlConn{
+		buf:     newBuffer(),
+		netConn: client,
+		rawConn: client,
+		cfg:     NewConfig(),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := mc.watchCancel(ctx); err != nil {
+		t.Fatal(err)
+	}
+	// the server never answers
+	if _, err := mc.readPacket(); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("got %v, want context.DeadlineExceeded", err)
+	}
+	mc.finish()
+	if mc.deadlineSet {
+		t.Error("finish did not reset the deadline")
+	}
+}
+
+func TestContextCancelClosesConn(t *testing.T) {
+	client, server := net.Pipe()
+	defer server.Close()
+	mc := &mysqlConn{
+		buf:     newBuffer(),
+		netConn: client,
+		rawConn: client,
+		cfg:     NewConfig(),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := mc.watchCancel(ctx); err != nil {
+		t.Fatal(err)
+	}
+	time.AfterFunc(10*time.Millisecond, cancel)
+	// the server never answers
+	if _, err := mc.readPacket(); err != context.Canceled {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+	mc.finish()
+	if !mc.closed.Load() {
+		t.Error("the connection was not closed")
+	}
+	if err := mc.error(); err != context.Canceled {
+		t.Errorf("error() = %v, want context.Canceled", err)
+	}
+
+	// finish after a command completed in time doesn't close the connection
+	mc = newMockConn(nil)
+	ctx, cancel = context.WithCancel(context.Background())
+	if err := mc.watchCancel(ctx); err != nil {
+		t.Fatal(err)
+	}
+	mc.finish()
+	cancel()
+	if mc.closed.Load() {
+		t.Error("the connection was closed after finish")
+	}
+}
+
+func TestContextReusedAcrossCommands(t *testing.T) {
+	mc := newMockConn(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// commands with the same context or one derived with WithValue share
+	// the AfterFunc
+	allocs := testing.AllocsPerRun(100, func() {
+		if err := mc.watchCancel(WithFetchWarnings(ctx, true)); err != nil {
+			t.Fatal(err)
+		}
+		mc.finish()
+	})
+	if allocs > 1 { // the context.WithValue
+		t.Errorf("%v allocations per command, want 1", allocs)
+	}
+
+	// canceling the context between commands leaves the connection open
+	if err := mc.watchCancel(ctx); err != nil {
+		t.Fatal(err)
+	}
+	mc.finish()
+	cancel()
+	time.Sleep(10 * time.Millisecond) // let the AfterFunc run
+	if mc.closed.Load() {
+		t.Error("the connection was closed between commands")
+	}
+	if err := mc.watchCancel(ctx); err != context.Canceled {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+}
+
+// killStub replaces connector.killQuery. It blocks until release is closed.
+type killStub struct {
+	started chan uint32
+	release chan struct{}
+	err     error
+}
+
+func (k *killStub) kill(ctx context.Context, threadID uint32) error {
+	k.started <- threadID
+	<-k.release
+	return k.err
+}
+
+func newKillQueryConn(k *killStub) *mysqlConn {
+	mc := newMockConn(nil)
+	mc.cfg.KillQueryOnCancel = true
+	mc.connector = &connector{cfg: mc.cfg, kill: k.kill}
+	mc.finished = make(chan struct{}, 1)
+	mc.server.ThreadID = 42
+	return mc
+}
+
+func TestKillQueryOnCancel(t *testing.T) {
+	k := &killStub{started: make(chan uint32, 1), release: make(chan struct{})}
+	mc := newKillQueryConn(k)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := mc.watchCancel(ctx); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	if id := <-k.started; id != 42 {
+		t.Errorf("killed thread %d, want 42", id)
+	}
+
+	// the interrupted command fails and finishes while the KILL is still
+	// running; finish must wait for it
+	finished := make(chan struct{})
+	go func() {
+		mc.finish()
+		close(finished)
+	}()
+	select {
+	case <-finished:
+		t.Fatal("finish returned before the KILL was done")
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(k.release)
+	<-finished
+
+	if len(mc.finished) != 0 {
+		t.Error("finish left a token in mc.finished")
+	}
+	if mc.closed.Load() {
+		t.Error("the connection was closed")
+	}
+	if !mc.killed.Load() || mc.killedErr.Value() != context.Canceled {
+		t.Errorf("killed = %v, killedErr = %v", mc.killed.Load(), mc.killedErr.Value())
+	}
+
+	// the next command is neither killed nor woken up by a stale token
+	ctx2, cancel2 := context.WithCancel(context.Background())
+	defer cancel2()
+	if err := mc.watchCancel(ctx2); err != nil {
+		t.Fatal(err)
+	}
+	if mc.killed.Load() {
+		t.Error("killed was not reset")
+	}
+	mc.finish()
+	select {
+	case <-k.started:
+		t.Error("the next command was killed")
+	default:
+	}
+}
+
+func TestKillQueryCommandFinishesFirst(t *testing.T) {
+	k := &killStub{started: make(chan uint32, 1), release: make(chan struct{})}
+	mc := newKillQueryConn(k)
+	errPkt := append([]byte{0xff, 0x25, 0x05, '#', '7', '0', '1', '0', '0'}, "Query execution was interrupted"...)
+	mc.netConn.(*mockConn).reads = [][]byte{
+		mockResponse(1, errPkt),
+		mockResponse(1, []byte{iOK, 1, 0, 0, 0, 0, 0}),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// the server reports the interrupted query while the side connection
+	// is still busy with the KILL
+	mc.netConn.(*mockConn).onRead = func() {
+		cancel()
+		<-k.started
+	}
+	errc := make(chan error, 1)
+	go func() {
+		_, err := mc.ExecContext(ctx, "SELECT SLEEP(10)", nil)
+		errc <- err
+	}()
+	select {
+	case err := <-errc:
+		t.Fatalf("Exec returned %v before the KILL was done", err)
+	case <-time.After(20 * time.Millisecond):
+	}
+	close(k.release)
+	if err := <-errc; err != context.Canceled {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+
+	// the KILL was waited for, the connection stays usable
+	if mc.closed.Load() {
+		t.Fatal("the connection was closed")
+	}
+	res, err := mc.ExecContext(context.Background(), "DELETE FROM t", nil)
+	if err != nil {
+		t.Fatalf("next command: %v", err)
+	}
+	if n, _ := res.RowsAffected(); n != 1 {
+		t.Errorf("RowsAffected() = %d, want 1", n)
+	}
+}
+
+func TestKillQueryOnCancelFails(t *testing.T) {
+	k := &killStub{started: make(chan uint32, 1), release: make(chan struct{}), err: errors.New("connection refused")}
+	close(k.release)
+	mc := newKillQueryConn(k)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	if err := mc.watchCancel(ctx); err != nil {
+		t.Fatal(err)
+	}
+	cancel()
+	<-k.started
+	mc.finish()
+
+	// without the KILL, the connection is closed like without killQueryOnCancel
+	if !mc.closed.Load() {
+		t.Error("the connection was not closed")
+	}
+	if err := mc.error(); err != context.Canceled {
+		t.Errorf("error() = %v, want context.Canceled", err)
+	}
+	if len(mc.finished) != 0 {
+		t.Error("finish left a token in mc.finished")
+	}
+}
+
+func TestPrepareContextCanceled(t *testing.T) {
+	k := &killStub{started: make(chan uint32, 1), release: make(chan struct{})}
+	close(k.release)
+	mc := newKillQueryConn(k)
+	mc.stmtCache = newStmtCache(2)
+	// COM_STMT_PREPARE OK: statement 7 without columns or parameters
+	mc.netConn.(*mockConn).reads = [][]byte{mockResponse(1, []byte{iOK, 7, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	// the context is canceled while the server prepares the statement and
+	// the query is killed, but the statement is prepared anyway
+	mc.netConn.(*mockConn).onRead = func() {
+		cancel()
+		<-k.started
+	}
+	if _, err := mc.PrepareContext(ctx, "SELECT 1"); err != context.Canceled {
+		t.Fatalf("got %v, want context.Canceled", err)
+	}
+
+	if mc.closed.Load() {
+		t.Fatal("the connection was closed")
+	}
+	wantCommands(t, mc, append([]byte{comStmtPrepare}, "SELECT 1"...), []byte{comStmtClose, 7, 0, 0, 0})
+	if n := mc.StmtCacheStats().Size; n != 0 {
+		t.Errorf("%d statements cached, want 0", n)
+	}
+}
+
+// BenchmarkWatchCancel measures the overhead context cancellation adds to
+// commands sharing a context.
+func BenchmarkWatchCancel(b *testing.B) {
+	mc := newMockConn(nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b.ReportAllocs()
+	for b.Loop() {
+		if err := mc.watchCancel(ctx); err != nil {
+			b.Fatal(err)
+		}
+		mc.finish()
+	}
+}
+
+// BenchmarkWatchCancelNewContext measures the overhead for commands with
+// a context of their own. The contexts are created before the timer starts.
+func BenchmarkWatchCancelNewContext(b *testing.B) {
+	mc := newMockConn(nil)
+	ctxs := make([]context.Context, b.N)
+	for i := range ctxs {
+		var cancel context.CancelFunc
+		ctxs[i], cancel = context.WithCancel(context.Background())
+		defer cancel()
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := mc.watchCancel(ctxs[i]); err != nil {
+			b.Fatal(err)
+		}
+		mc.finish()
+	}
+}
+
+func BenchmarkWatchCancelParallel(b *testing.B) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		mc := newMockConn(nil)
+		for pb.Next() {
+			if err := mc.watchCancel(ctx); err != nil {
+				b.Error(err)
+				return
+			}
+			mc.finish()
+		}
+	})
+}
+
+// BenchmarkNewConn measures the per-connection setup, which doesn't
+// include a goroutine watching for cancellation.
+func BenchmarkNewConn(b *testing.B) {
+	b.ReportAllocs()
+	for b.Loop() {
+		mc := newMockConn(nil)
+		mc.cleanup()
+	}
+}
+
+func TestHandleParamsCharsets(t *testing.T) {
+	cfg, err := ParseDSN("/?charset=utf8mb3,latin1&collation=latin1_swedish_ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	errPkt := append([]byte{iERR, 0x5b, 0x04, '#', '4', '2', '0', '0', '0'}, "Unknown character set: 'utf8mb3'"...)
+	mc := newMockConnData(mockResponse(1, errPkt), mockResponse(1, []byte{iOK, 0, 0, 0, 0, 0, 0}))
+	mc.cfg = cfg
+
+	// the first charset the server accepts is used
+	if err := mc.handleParams(); err != nil {
+		t.Fatal(err)
+	}
+	wantCommands(t, mc,
+		[]byte("\x03SET NAMES utf8mb3"),
+		[]byte("\x03SET NAMES latin1 COLLATE latin1_swedish_ci"),
+	)
+	if mc.charset != "latin1" {
+		t.Errorf("charset = %q, want latin1", mc.charset)
+	}
+}
+
+func TestHandleParamsSystemVariables(t *testing.T) {
+	cfg, err := ParseDSN("/?sys.time_zone='%2B00:00'&sys.sql_mode=CONCAT(@@sql_mode,',STRICT_ALL_TABLES')&sys.autocommit=1&initCommands=SET%20@a%20=%201,DO%20@a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok := mockResponse(1, []byte{iOK, 0, 0, 0, 0, 0, 0})
+	mc := newMockConnData(ok, ok, ok)
+	mc.cfg = cfg
+
+	// the values are written verbatim in one SET, followed by the init commands
+	if err := mc.handleParams(); err != nil {
+		t.Fatal(err)
+	}
+	wantCommands(t, mc,
+		[]byte("\x03SET autocommit = 1, sql_mode = CONCAT(@@sql_mode,',STRICT_ALL_TABLES'), time_zone = '+00:00'"),
+		[]byte("\x03SET @a = 1"),
+		[]byte("\x03DO @a"),
+	)
+}
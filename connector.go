@@ -3,21 +3,32 @@ package mysqldriver
 import (
 	"context"
 	"database/sql/driver"
+	"fmt"
+	"maps"
 	"net"
 	"os"
+	"slices"
 	"strconv"
 )
 
 type connector struct {
 	cfg               *Config
 	encodedAttributes string
+
+	// kill interrupts the command of another connection, c.killQuery
+	// unless replaced by tests
+	kill func(ctx context.Context, threadID uint32) error
 }
 
 func encodeConnectionAttributes(cfg *Config) string {
 	connAttrsBuf := make([]byte, 0)
 
+	clientName := connAttrClientNameValue
+	if v, ok := cfg.ConnectionAttributes[connAttrClientName]; ok {
+		clientName = v
+	}
 	connAttrsBuf = appendLengthEncodedString(connAttrsBuf, connAttrClientName)
-	connAttrsBuf = appendLengthEncodedString(connAttrsBuf, connAttrClientNameValue)
+	connAttrsBuf = appendLengthEncodedString(connAttrsBuf, clientName)
 	connAttrsBuf = appendLengthEncodedString(connAttrsBuf, connAttrOS)
 	connAttrsBuf = appendLengthEncodedString(connAttrsBuf, connAttrOSValue)
 	connAttrsBuf = appendLengthEncodedString(connAttrsBuf, connAttrPlatform)
@@ -30,17 +41,57 @@ func encodeConnectionAttributes(cfg *Config) string {
 		connAttrsBuf = appendLengthEncodedString(connAttrsBuf, serverHost)
 	}
 
-	// TODO: user-defined connection attributes
+	// user-defined connection attributes, sorted to send them in a stable order
+	for _, k := range slices.Sorted(maps.Keys(cfg.ConnectionAttributes)) {
+		if k == connAttrClientName {
+			continue
+		}
+		connAttrsBuf = appendLengthEncodedString(connAttrsBuf, k)
+		connAttrsBuf = appendLengthEncodedString(connAttrsBuf, cfg.ConnectionAttributes[k])
+	}
 
 	return string(connAttrsBuf)
 }
 
 func newConnector(cfg *Config) *connector {
 	encodedAttributes := encodeConnectionAttributes(cfg)
-	return &connector{
+	c := &connector{
 		cfg:               cfg,
 		encodedAttributes: encodedAttributes,
 	}
+	c.kill = c.killQuery
+	return c
+}
+
+// killQuery interrupts the command running on the connection with the given
+// thread ID. It connects with the same address and credentials, but without
+// a database, session variables or init commands.
+func (c *connector) killQuery(ctx context.Context, threadID uint32) error {
+	cfg := c.cfg.Clone()
+	cfg.DBName = ""
+	cfg.Params = nil
+	cfg.InitCommands = nil
+	cfg.StmtCacheSize = 0
+	cfg.KillQueryOnCancel = false
+	if cfg.MaxAllowedPacket == 0 {
+		cfg.MaxAllowedPacket = defaultMaxAllowedPacket
+	}
+
+	conn, err := (&connector{cfg: cfg, encodedAttributes: c.encodedAttributes}).Connect(ctx)
+	if err != nil {
+		return err
+	}
+	mc := conn.(*mysqlConn)
+	defer mc.cleanup()
+
+	if err := mc.watchCancel(ctx); err != nil {
+		return err
+	}
+	defer mc.finish()
+	if err := mc.exec("KILL QUERY " + strconv.FormatUint(uint64(threadID), 10)); err != nil {
+		return err
+	}
+	return mc.writeCommandPacket(comQuit)
 }
 
 func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
@@ -48,8 +99,9 @@ func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
 
 	mc := &mysqlConn{
 		cfg:       c.cfg,
-		closech:   make(chan struct{}),
 		connector: c,
+
+		maxAllowedPacket: maxPacketSize,
 	}
 
 	dctx := ctx
@@ -75,7 +127,9 @@ func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
 		}
 	}
 
-	mc.startWatcher()
+	if c.cfg.KillQueryOnCancel {
+		mc.finished = make(chan struct{}, 1)
+	}
 	if err := mc.watchCancel(ctx); err != nil {
 		mc.cleanup()
 		return nil, err
@@ -109,8 +163,40 @@ func (c *connector) Connect(ctx context.Context) (driver.Conn, error) {
 		return nil, err
 	}
 
+	// the limit applies to the SET statements and init commands too
 	if mc.cfg.MaxAllowedPacket > 0 {
 		mc.maxAllowedPacket = mc.cfg.MaxAllowedPacket
+	} else {
+		// Get max allowed packet size
+		maxap, err := mc.getSystemVar("max_allowed_packet")
+		if err != nil {
+			mc.cleanup()
+			return nil, err
+		}
+		n, err := strconv.Atoi(maxap)
+		if err != nil {
+			mc.cleanup()
+			return nil, fmt.Errorf("invalid max_allowed_packet value (%q): %w", maxap, err)
+		}
+		if n < 1 {
+			mc.cleanup()
+			return nil, fmt.Errorf("invalid max_allowed_packet value (%q): not positive", maxap)
+		}
+		// One byte less, as in go-sql-driver/mysql: the server rejects a
+		// payload of max_allowed_packet bytes or more (length >=
+		// max_packet_size in net_realloc), and the payload checked by
+		// writePacket includes the command byte.
+		mc.maxAllowedPacket = n - 1
+	}
+
+	// Handle DSN Params
+	if err = mc.handleParams(); err != nil {
+		mc.cleanup()
+		return nil, err
+	}
+
+	if mc.cfg.StmtCacheSize > 0 {
+		mc.stmtCache = newStmtCache(mc.cfg.StmtCacheSize)
 	}
 
 	return mc, nil
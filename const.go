@@ -1,11 +1,17 @@
 package mysqldriver
 
-import "runtime"
+import (
+	"runtime"
+	"time"
+)
 
 const (
-	maxPacketSize = 1<<24 - 1 // 16,777,215
+	minProtocolVersion = 10
+	killQueryTimeout   = 5 * time.Second // to kill a canceled query and read its result
+	maxPacketSize      = 1<<24 - 1       // 16,777,215
 
 	defaultMaxAllowedPacket = 64 << 20 // 64 MiB. See https://github.com/go-sql-driver/mysql/issues/1355
+	longDataChunkSize       = 1 << 20  // 1 MiB, the most read from an io.Reader parameter at once
 
 	// Connection attributes
 	// See https://dev.mysql.com/doc/refman/8.0/en/performance-schema-connection-attribute-tables.html#performance-schema-connection-attributes-available
@@ -17,6 +23,13 @@ const (
 	connAttrPlatformValue   = runtime.GOARCH
 	connAttrPid             = "_pid"
 	connAttrServerHost      = "_server_host"
+
+	// Limits of the server for connection attributes, longer names and values
+	// are truncated and oversized attribute data is dropped.
+	// See https://dev.mysql.com/doc/refman/8.0/en/performance-schema-connection-attribute-tables.html#performance-schema-connection-attribute-limits
+	connAttrMaxKeyLen   = 32
+	connAttrMaxValueLen = 1024
+	connAttrsMaxLen     = 64 * 1024
 )
 const (
 	iOK           byte = 0x00
@@ -87,6 +100,12 @@ const (
 	comStmtFetch
 )
 
+// https://dev.mysql.com/doc/dev/mysql-server/latest/mysql__com_8h.html#a3e5e9e744ff6f7b989a604fd669977da
+const (
+	cursorTypeNoCursor byte = iota
+	cursorTypeReadOnly
+)
+
 type statusFlag uint16
 
 const (
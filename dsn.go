@@ -1,10 +1,16 @@
 package mysqldriver
 
 import (
+	"bytes"
 	"crypto/rsa"
 	"errors"
 	"fmt"
+	"maps"
+	"math/big"
+	"net"
 	"net/url"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -15,16 +21,200 @@ type Config struct {
 	Net              string // Network (e.g. "tcp", "tcp6", "unix". default: "tcp")
 	Addr             string // Address (default: "127.0.0.1:3306" for "tcp" and "/tmp/mysql.sock" for "unix")
 	DBName           string
+	Collation        string // Connection collation (default: the server's utf8mb4 collation or "utf8mb4_general_ci")
 	Loc              *time.Location
-	MaxAllowedPacket int
+	MaxAllowedPacket int // Max packet size allowed (default: 64 MiB, 0: the server's max_allowed_packet)
+	FetchSize        int // Rows per COM_STMT_FETCH for prepared statement queries (0: no cursor)
+	StmtCacheSize    int // Prepared statements cached per connection (0: no cache)
+
+	// Additional connection attributes reported in
+	// performance_schema.session_connect_attrs. "_client_name" overrides the
+	// built-in value, other names starting with "_" are reserved.
+	ConnectionAttributes map[string]string
+
+	// Session system variables set right after connecting, e.g.
+	// {"sql_mode": "'STRICT_ALL_TABLES'", "autocommit": "1"}. In the DSN
+	// they are given with the prefix "sys.", e.g. sys.autocommit=1. Values
+	// are written into a single SET statement verbatim, so they are trusted
+	// SQL: strings must be quoted, and a DSN must not be built from
+	// untrusted input.
+	Params map[string]string
+
+	// SQL statements executed in order after the system variables are set.
+	// In the DSN they are given as initCommands, separated by commas, with
+	// commas inside a statement escaped as %2C.
+	InitCommands []string
 
 	InterpolateParams bool
-	ParseTime         bool
+
+	// Send the time left until the context deadline as execution time limit
+	// of SELECT statements. It only applies to text queries, not to prepared
+	// statements, which can't carry a different limit for every execution.
+	// Queries with arguments are prepared unless interpolateParams is set.
+	DeadlineToServer bool
+
+	ParseTime      bool
+	DecodeCharsets bool // Transcode text in non-UTF-8 character sets (e.g. cp932, ujis, latin1) from and to UTF-8
+	FetchWarnings  bool // Read the warnings of statements with SHOW WARNINGS, see MySQLWarnings
+
+	// Interrupt canceled queries with KILL QUERY, sent over a new connection,
+	// instead of closing the connection. The canceled command returns once
+	// the KILL is done, which takes a dial and authentication and can delay
+	// it by up to 5 seconds. If the KILL fails, the connection is closed.
+	KillQueryOnCancel bool
 
 	pubKey   *rsa.PublicKey
 	charsets []string
 }
 
+// Clone returns a deep copy of cfg.
+func (cfg *Config) Clone() *Config {
+	cp := *cfg
+	cp.charsets = slices.Clone(cfg.charsets)
+	cp.ConnectionAttributes = maps.Clone(cfg.ConnectionAttributes)
+	cp.Params = maps.Clone(cfg.Params)
+	cp.InitCommands = slices.Clone(cfg.InitCommands)
+	if cfg.pubKey != nil {
+		cp.pubKey = &rsa.PublicKey{
+			N: new(big.Int).Set(cfg.pubKey.N),
+			E: cfg.pubKey.E,
+		}
+	}
+	return &cp
+}
+
+// FormatDSN formats the given Config into a DSN string which can be passed to
+// the driver. Driver parameters are written in alphabetical order and only if
+// they differ from their default, followed by the system variables in Params
+// sorted by name and prefixed with "sys.", so equal configs give equal DSNs.
+//
+// Unlike everything else, the password is written unescaped, because
+// ParseDSN takes it verbatim. This only round-trips because ParseDSN splits
+// the credentials at the last '@' before the slash of the database name and
+// the user name at the first ':', so the password may contain any character.
+// Escaping it would change the meaning of existing DSNs with '%' in the
+// password.
+func (cfg *Config) FormatDSN() string {
+	var buf bytes.Buffer
+
+	// [username[:password]@]
+	if len(cfg.User) > 0 || len(cfg.Passwd) > 0 {
+		buf.WriteString(cfg.User)
+		if len(cfg.Passwd) > 0 {
+			buf.WriteByte(':')
+			buf.WriteString(cfg.Passwd)
+		}
+		buf.WriteByte('@')
+	}
+
+	// [protocol[(address)]]
+	if len(cfg.Net) > 0 {
+		buf.WriteString(cfg.Net)
+		if len(cfg.Addr) > 0 {
+			buf.WriteByte('(')
+			buf.WriteString(cfg.Addr)
+			buf.WriteByte(')')
+		}
+	}
+
+	// /dbname
+	buf.WriteByte('/')
+	buf.WriteString(url.PathEscape(cfg.DBName))
+
+	// [?param1=value1&...&paramN=valueN]
+	hasParam := false
+
+	if len(cfg.charsets) > 0 {
+		writeDSNParam(&buf, &hasParam, "charset", strings.Join(cfg.charsets, ","))
+	}
+
+	if len(cfg.Collation) > 0 {
+		writeDSNParam(&buf, &hasParam, "collation", cfg.Collation)
+	}
+
+	if len(cfg.ConnectionAttributes) > 0 {
+		attrs := make([]string, 0, len(cfg.ConnectionAttributes))
+		for _, k := range slices.Sorted(maps.Keys(cfg.ConnectionAttributes)) {
+			attrs = append(attrs, url.QueryEscape(k)+":"+url.QueryEscape(cfg.ConnectionAttributes[k]))
+		}
+		writeDSNParam(&buf, &hasParam, "connectionAttributes", strings.Join(attrs, ","))
+	}
+
+	if cfg.DeadlineToServer {
+		writeDSNParam(&buf, &hasParam, "deadlineToServer", "true")
+	}
+
+	if cfg.DecodeCharsets {
+		writeDSNParam(&buf, &hasParam, "decodeCharsets", "true")
+	}
+
+	if cfg.FetchSize != 0 {
+		writeDSNParam(&buf, &hasParam, "fetchSize", strconv.Itoa(cfg.FetchSize))
+	}
+
+	if cfg.FetchWarnings {
+		writeDSNParam(&buf, &hasParam, "fetchWarnings", "true")
+	}
+
+	if len(cfg.InitCommands) > 0 {
+		cmds := make([]string, len(cfg.InitCommands))
+		for i, cmd := range cfg.InitCommands {
+			cmds[i] = escapeDSNParamValue(cmd)
+		}
+		writeDSNParam(&buf, &hasParam, "initCommands", strings.Join(cmds, ","))
+	}
+
+	if cfg.InterpolateParams {
+		writeDSNParam(&buf, &hasParam, "interpolateParams", "true")
+	}
+
+	if cfg.KillQueryOnCancel {
+		writeDSNParam(&buf, &hasParam, "killQueryOnCancel", "true")
+	}
+
+	if cfg.Loc != nil {
+		writeDSNParam(&buf, &hasParam, "loc", url.QueryEscape(cfg.Loc.String()))
+	}
+
+	if cfg.MaxAllowedPacket != defaultMaxAllowedPacket {
+		writeDSNParam(&buf, &hasParam, "maxAllowedPacket", strconv.Itoa(cfg.MaxAllowedPacket))
+	}
+
+	if cfg.ParseTime {
+		writeDSNParam(&buf, &hasParam, "parseTime", "true")
+	}
+
+	if cfg.StmtCacheSize != 0 {
+		writeDSNParam(&buf, &hasParam, "stmtCacheSize", strconv.Itoa(cfg.StmtCacheSize))
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(cfg.Params)) {
+		writeDSNParam(&buf, &hasParam, sysVarPrefix+k, escapeDSNParamValue(cfg.Params[k]))
+	}
+
+	return buf.String()
+}
+
+// escapeDSNParamValue escapes a system variable value or init command so that
+// url.PathUnescape restores it. Unlike url.QueryEscape it keeps '+' distinct
+// from a space, which matters for values like '+00:00'.
+func escapeDSNParamValue(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "+", "%20")
+}
+
+func writeDSNParam(buf *bytes.Buffer, hasParam *bool, name, value string) {
+	buf.Grow(1 + len(name) + 1 + len(value))
+	if !*hasParam {
+		*hasParam = true
+		buf.WriteByte('?')
+	} else {
+		buf.WriteByte('&')
+	}
+	buf.WriteString(name)
+	buf.WriteByte('=')
+	buf.WriteString(value)
+}
+
 func ParseDSN(dsn string) (cfg *Config, err error) {
 	cfg = NewConfig()
 
@@ -90,20 +280,114 @@ func ParseDSN(dsn string) (cfg *Config, err error) {
 	return cfg, nil
 }
 
+// sysVarPrefix marks DSN parameters setting a system variable. Without it, a
+// misspelled driver parameter would be taken for a system variable and only
+// fail when connecting, or not at all.
+const sysVarPrefix = "sys."
+
+// dsnParams lists the parameters understood by parseDSNParams.
+var dsnParams = []string{
+	"charset",
+	"collation",
+	"connectionAttributes",
+	"deadlineToServer",
+	"decodeCharsets",
+	"fetchSize",
+	"fetchWarnings",
+	"initCommands",
+	"interpolateParams",
+	"killQueryOnCancel",
+	"loc",
+	"maxAllowedPacket",
+	"parseTime",
+	"stmtCacheSize",
+}
+
 func parseDSNParams(cfg *Config, params string) (err error) {
 	for _, v := range strings.Split(params, "&") {
+		if v == "" {
+			continue
+		}
 		key, value, found := strings.Cut(v, "=")
 		if !found {
-			continue
+			return fmt.Errorf("invalid DSN parameter %q: missing '=' and value", v)
 		}
 		switch key {
 		case "charset":
 			cfg.charsets = strings.Split(value, ",")
+			if slices.Contains(cfg.charsets, "") {
+				return fmt.Errorf("invalid value for charset: %q contains an empty charset name", value)
+			}
+			// the names are sent in SET NAMES as they are
+			for _, charset := range cfg.charsets {
+				if !isCharset(charset) {
+					return errors.New("unknown charset: " + charset)
+				}
+			}
+		case "connectionAttributes":
+			if cfg.ConnectionAttributes == nil {
+				cfg.ConnectionAttributes = make(map[string]string)
+			}
+			for _, attr := range strings.Split(value, ",") {
+				k, v, found := strings.Cut(attr, ":")
+				if !found {
+					return fmt.Errorf("invalid connection attribute %q: expected key:value", attr)
+				}
+				if k, err = url.QueryUnescape(k); err != nil {
+					return
+				}
+				if v, err = url.QueryUnescape(v); err != nil {
+					return
+				}
+				cfg.ConnectionAttributes[k] = v
+			}
+		case "collation":
+			if _, ok := collations[value]; !ok {
+				return errors.New("unknown collation: " + value)
+			}
+			cfg.Collation = value
+		case "deadlineToServer":
+			if cfg.DeadlineToServer, err = readDSNBool(key, value); err != nil {
+				return
+			}
+		case "decodeCharsets":
+			if cfg.DecodeCharsets, err = readDSNBool(key, value); err != nil {
+				return
+			}
+		case "initCommands":
+			cfg.InitCommands = strings.Split(value, ",")
+			for i, cmd := range cfg.InitCommands {
+				if cfg.InitCommands[i], err = url.PathUnescape(cmd); err != nil {
+					return fmt.Errorf("invalid value for initCommands: %w", err)
+				}
+			}
+		case "interpolateParams":
+			if cfg.InterpolateParams, err = readDSNBool(key, value); err != nil {
+				return
+			}
+		case "killQueryOnCancel":
+			if cfg.KillQueryOnCancel, err = readDSNBool(key, value); err != nil {
+				return
+			}
+		case "maxAllowedPacket":
+			if cfg.MaxAllowedPacket, err = readDSNInt(key, value); err != nil {
+				return
+			}
 		case "parseTime":
-			var isBool bool
-			cfg.ParseTime, isBool = readBool(value)
-			if !isBool {
-				return errors.New("invalid bool value: " + value)
+			if cfg.ParseTime, err = readDSNBool(key, value); err != nil {
+				return
+			}
+		case "fetchSize":
+			if cfg.FetchSize, err = readDSNInt(key, value); err != nil {
+				return
+			}
+		case "fetchWarnings":
+			if cfg.FetchWarnings, err = readDSNBool(key, value); err != nil {
+				return
+			}
+		case "stmtCacheSize":
+			if cfg.StmtCacheSize, err = readDSNInt(key, value); err != nil {
+				return
 			}
 		case "loc":
 			if value, err = url.QueryUnescape(value); err != nil {
@@ -111,17 +395,178 @@ func parseDSNParams(cfg *Config, params string) (err error) {
 			}
 			cfg.Loc, err = time.LoadLocation(value)
 			if err != nil {
-				return
+				return fmt.Errorf("invalid value for loc: %w", err)
+			}
+		default:
+			name, isSysVar := strings.CutPrefix(key, sysVarPrefix)
+			if !isSysVar {
+				if suggestion := closestDSNParam(key); suggestion != "" {
+					return fmt.Errorf("unknown DSN parameter %q (did you mean %q?)", key, suggestion)
+				}
+				if isSysVarName(key) {
+					return fmt.Errorf("unknown DSN parameter %q (system variables need the prefix %q)", key, sysVarPrefix)
+				}
+				return fmt.Errorf("unknown DSN parameter %q", key)
+			}
+			if !isSysVarName(name) {
+				return fmt.Errorf("invalid DSN parameter %q: not a system variable name", key)
+			}
+			if cfg.Params == nil {
+				cfg.Params = make(map[string]string)
+			}
+			if cfg.Params[name], err = url.PathUnescape(value); err != nil {
+				return fmt.Errorf("invalid value for %s: %w", key, err)
 			}
 		}
 	}
 	return
 }
 
+func readDSNBool(key, value string) (bool, error) {
+	b, isBool := readBool(value)
+	if !isBool {
+		return false, fmt.Errorf("invalid value for %s: %q is not a bool (use true or false)", key, value)
+	}
+	return b, nil
+}
+
+func readDSNInt(key, value string) (int, error) {
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid value for %s: %q is not a non-negative integer", key, value)
+	}
+	return n, nil
+}
+
+// isSysVarName reports whether name can be used unquoted as a system variable
+// name in a SET statement.
+func isSysVarName(name string) bool {
+	if name == "" {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
+
+// closestDSNParam returns the known parameter most similar to key, or ""
+// if none is close enough to be a likely typo.
+func closestDSNParam(key string) string {
+	best, bestDist := "", 3 // suggest up to two edits
+	for _, param := range dsnParams {
+		if strings.EqualFold(param, key) {
+			return param
+		}
+		if d := levenshtein(strings.ToLower(key), strings.ToLower(param)); d < bestDist {
+			best, bestDist = param, d
+		}
+	}
+	return best
+}
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
 func (cfg *Config) normalize() error {
+	// Set default network if empty
 	if cfg.Net == "" {
 		cfg.Net = "tcp"
 	}
+
+	// Set default address if empty
+	if cfg.Addr == "" {
+		switch cfg.Net {
+		case "tcp", "tcp4", "tcp6":
+			cfg.Addr = "127.0.0.1:3306"
+		case "unix":
+			cfg.Addr = "/tmp/mysql.sock"
+		default:
+			return errors.New("default addr for network '" + cfg.Net + "' unknown")
+		}
+	} else if strings.HasPrefix(cfg.Net, "tcp") {
+		addr, err := ensureHavePort(cfg.Addr)
+		if err != nil {
+			return err
+		}
+		cfg.Addr = addr
+	}
+
+	if err := cfg.checkConnectionAttributes(); err != nil {
+		return err
+	}
+
+	if slices.Contains(cfg.InitCommands, "") {
+		return errors.New("invalid init commands: empty statement")
+	}
+
+	for k := range cfg.Params {
+		if !isSysVarName(k) {
+			return fmt.Errorf("invalid system variable name %q", k)
+		}
+	}
+	return nil
+}
+
+// ensureHavePort adds the default port to addresses without one, e.g.
+// "localhost", "::1" or "[::1]".
+func ensureHavePort(addr string) (string, error) {
+	if _, _, err := net.SplitHostPort(addr); err == nil {
+		return addr, nil
+	}
+	host := addr
+	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		host = host[1 : len(host)-1]
+	}
+	addr = net.JoinHostPort(host, "3306")
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		return "", fmt.Errorf("invalid address %q: %w", host, err)
+	}
+	return addr, nil
+}
+
+// checkConnectionAttributes rejects attributes the server would truncate or
+// drop instead of reporting them.
+func (cfg *Config) checkConnectionAttributes() error {
+	if len(cfg.ConnectionAttributes) == 0 {
+		return nil
+	}
+	for k, v := range cfg.ConnectionAttributes {
+		switch {
+		case k == "":
+			return errors.New("invalid connection attribute: empty name")
+		case strings.HasPrefix(k, "_") && k != connAttrClientName:
+			return fmt.Errorf("invalid connection attribute %q: names starting with '_' are reserved", k)
+		case len(k) > connAttrMaxKeyLen:
+			return fmt.Errorf("invalid connection attribute %q: name longer than %d bytes", k, connAttrMaxKeyLen)
+		case len(v) > connAttrMaxValueLen:
+			return fmt.Errorf("invalid connection attribute %q: value longer than %d bytes", k, connAttrMaxValueLen)
+		}
+	}
+	if n := len(encodeConnectionAttributes(cfg)); n > connAttrsMaxLen {
+		return fmt.Errorf("connection attributes too long: %d bytes (max %d)", n, connAttrsMaxLen)
+	}
 	return nil
 }
 
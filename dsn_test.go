@@ -0,0 +1,254 @@
+package mysqldriver
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testDSNs = []struct {
+	in  string
+	out *Config
+}{{
+	"user:password@tcp(localhost:9910)/test?charset=utf8&parseTime=True&loc=Local",
+	&Config{User: "user", Passwd: "password", Net: "tcp", Addr: "localhost:9910", DBName: "test", Loc: time.Local, MaxAllowedPacket: defaultMaxAllowedPacket, ParseTime: true, charsets: []string{"utf8"}},
+}, {
+	"user:p@ss:w/rd@unix(/tmp/mysql.sock)/db%2Fname?collation=utf8mb4_0900_ai_ci&fetchSize=100&stmtCacheSize=16",
+	&Config{User: "user", Passwd: "p@ss:w/rd", Net: "unix", Addr: "/tmp/mysql.sock", DBName: "db/name", Collation: "utf8mb4_0900_ai_ci", MaxAllowedPacket: defaultMaxAllowedPacket, FetchSize: 100, StmtCacheSize: 16},
+}, {
+	"/dbname?connectionAttributes=service:api,pod:api-7f9%2C1&maxAllowedPacket=1024&decodeCharsets=true&fetchWarnings=true&interpolateParams=true",
+	&Config{Net: "tcp", Addr: "127.0.0.1:3306", DBName: "dbname", ConnectionAttributes: map[string]string{"service": "api", "pod": "api-7f9,1"}, MaxAllowedPacket: 1024, DecodeCharsets: true, FetchWarnings: true, InterpolateParams: true},
+}, {
+	"tcp(localhost)/?killQueryOnCancel=true&deadlineToServer=true",
+	&Config{Net: "tcp", Addr: "localhost:3306", MaxAllowedPacket: defaultMaxAllowedPacket, KillQueryOnCancel: true, DeadlineToServer: true},
+}, {
+	"/?initCommands=SET%20@a%20=%201,DO%20GREATEST(1%2C%202)",
+	&Config{Net: "tcp", Addr: "127.0.0.1:3306", MaxAllowedPacket: defaultMaxAllowedPacket, InitCommands: []string{"SET @a = 1", "DO GREATEST(1, 2)"}},
+}, {
+	"tcp([::1])/",
+	&Config{Net: "tcp", Addr: "[::1]:3306", MaxAllowedPacket: defaultMaxAllowedPacket},
+}, {
+	"tcp6(fe80::1%eth0)/",
+	&Config{Net: "tcp6", Addr: "[fe80::1%eth0]:3306", MaxAllowedPacket: defaultMaxAllowedPacket},
+}, {
+	"user@tcp([de:ad:be:ef::ca:fe]:80)/?",
+	&Config{User: "user", Net: "tcp", Addr: "[de:ad:be:ef::ca:fe]:80", MaxAllowedPacket: defaultMaxAllowedPacket},
+}, {
+	"tcp(localhost:3306)/?maxAllowedPacket=0",
+	&Config{Net: "tcp", Addr: "localhost:3306", MaxAllowedPacket: 0},
+}, {
+	"unix/",
+	&Config{Net: "unix", Addr: "/tmp/mysql.sock", MaxAllowedPacket: defaultMaxAllowedPacket},
+}, {
+	// sys.log is a system variable although "log" is close to "loc"
+	"/dbname?sys.sql_mode='STRICT_ALL_TABLES,NO_ZERO_DATE'&sys.time_zone='+00:00'&sys.autocommit=1&parseTime=true&sys.log=1",
+	&Config{Net: "tcp", Addr: "127.0.0.1:3306", DBName: "dbname", MaxAllowedPacket: defaultMaxAllowedPacket, ParseTime: true,
+		Params: map[string]string{"sql_mode": "'STRICT_ALL_TABLES,NO_ZERO_DATE'", "time_zone": "'+00:00'", "autocommit": "1", "log": "1"}},
+}}
+
+func TestDSNParser(t *testing.T) {
+	for i, tst := range testDSNs {
+		cfg, err := ParseDSN(tst.in)
+		if err != nil {
+			t.Fatalf("%d. %s: %v", i, tst.in, err)
+		}
+		if !reflect.DeepEqual(cfg, tst.out) {
+			t.Errorf("%d. ParseDSN(%q) mismatch:\ngot  %+v\nwant %+v", i, tst.in, cfg, tst.out)
+		}
+	}
+}
+
+func TestDSNParserInvalid(t *testing.T) {
+	var invalidDSNs = []struct {
+		in  string
+		err string
+	}{
+		{"user@tcp(localhost:3306)", "missing the slash"},
+		{"/dbname?parsetime=true", `unknown DSN parameter "parsetime" (did you mean "parseTime"?)`},
+		{"/dbname?stmtCachSize=8", `(did you mean "stmtCacheSize"?)`},
+		{"/dbname?sql-mode=ANSI", `unknown DSN parameter "sql-mode"`},
+		{"/dbname?readTimeout=30s", `unknown DSN parameter "readTimeout" (system variables need the prefix "sys.")`},
+		{"/dbname?autocommit=1", `unknown DSN parameter "autocommit" (system variables need the prefix "sys.")`},
+		{"/dbname?sys.sql-mode=ANSI", `invalid DSN parameter "sys.sql-mode": not a system variable name`},
+		{"/dbname?sys.=1", "not a system variable name"},
+		{"/dbname?sys.time_zone=%zz", "invalid value for sys.time_zone"},
+		{"/dbname?parseTime", `invalid DSN parameter "parseTime": missing '='`},
+		{"/dbname?parseTime=yes", `invalid value for parseTime: "yes" is not a bool`},
+		{"/dbname?fetchSize=-1", `invalid value for fetchSize: "-1"`},
+		{"/dbname?charset=utf8,", `invalid value for charset`},
+		{"/dbname?charset=utf8mb4,latin1%20COLLATE%20latin1_bin", "unknown charset"},
+		{"/dbname?charset=utf8;SET%20@a=1", "unknown charset"},
+		{"/dbname?charset=ucs2", "unknown charset"},
+		{"/dbname?collation=utf8_foo", "unknown collation"},
+		{"/dbname?loc=Mars%2FOlympus", "invalid value for loc"},
+		{"/dbname?connectionAttributes=_os:plan9", "reserved"},
+		{"/dbname?initCommands=DO%201,,DO%202", "empty statement"},
+		{"/dbname?initCommands=DO%zz", "invalid value for initCommands"},
+		{"foo/", "default addr for network 'foo' unknown"},
+		{"tcp([::1)/", "invalid address"},
+	}
+	for i, tst := range invalidDSNs {
+		_, err := ParseDSN(tst.in)
+		if err == nil || !strings.Contains(err.Error(), tst.err) {
+			t.Errorf("%d. ParseDSN(%q): got error %v, want %q", i, tst.in, err, tst.err)
+		}
+	}
+}
+
+func TestConnectionAttributesMaxLen(t *testing.T) {
+	cfg := NewConfig()
+	cfg.ConnectionAttributes = make(map[string]string)
+	value := strings.Repeat("v", connAttrMaxValueLen)
+	// add attributes of the maximum size as long as they fit into 64 KiB
+	for i := 0; ; i++ {
+		cfg.ConnectionAttributes[fmt.Sprintf("attr%02d", i)] = value
+		if len(encodeConnectionAttributes(cfg)) > connAttrsMaxLen {
+			break
+		}
+		if err := cfg.checkConnectionAttributes(); err != nil {
+			t.Fatalf("%d attributes: %v", i+1, err)
+		}
+	}
+	if err := cfg.checkConnectionAttributes(); err == nil || !strings.Contains(err.Error(), "too long") {
+		t.Errorf("%d attributes: got %v, want an error", len(cfg.ConnectionAttributes), err)
+	}
+}
+
+func TestDSNReformat(t *testing.T) {
+	for i, tst := range testDSNs {
+		cfg1, err := ParseDSN(tst.in)
+		if err != nil {
+			t.Fatalf("%d. %s: %v", i, tst.in, err)
+		}
+		dsn := cfg1.FormatDSN()
+		cfg2, err := ParseDSN(dsn)
+		if err != nil {
+			t.Fatalf("%d. %s: %v", i, dsn, err)
+		}
+		if !reflect.DeepEqual(cfg1, cfg2) {
+			t.Errorf("%d. %q reformatted as %q does not match:\ngot  %+v\nwant %+v", i, tst.in, dsn, cfg2, cfg1)
+		}
+		if dsn2 := cfg2.FormatDSN(); dsn2 != dsn {
+			t.Errorf("%d. FormatDSN is not canonical: %q != %q", i, dsn2, dsn)
+		}
+	}
+}
+
+func TestConfigClone(t *testing.T) {
+	cfg, err := ParseDSN(testDSNs[0].in + "&connectionAttributes=a:b&sys.autocommit=1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.InitCommands = []string{"DO 1"}
+	cp := cfg.Clone()
+	if !reflect.DeepEqual(cfg, cp) {
+		t.Fatalf("clone mismatch:\ngot  %+v\nwant %+v", cp, cfg)
+	}
+	cp.charsets[0] = "latin1"
+	cp.ConnectionAttributes["a"] = "c"
+	cp.Params["autocommit"] = "0"
+	cp.InitCommands[0] = "DO 2"
+	if cfg.charsets[0] != "utf8" || cfg.ConnectionAttributes["a"] != "b" ||
+		cfg.Params["autocommit"] != "1" || cfg.InitCommands[0] != "DO 1" {
+		t.Errorf("clone shares state with the original: %+v", cfg)
+	}
+}
+
+func TestFormatDSNPassword(t *testing.T) {
+	// ParseDSN takes the password verbatim, so it must not be escaped
+	for _, passwd := range []string{"p@ss", "p/ss", "p:ss", "@/:", ":@tcp(h)/", "%40%2F"} {
+		cfg := NewConfig()
+		cfg.User = "user"
+		cfg.Passwd = passwd
+		cfg.Net = "tcp"
+		cfg.Addr = "localhost:3306"
+		cfg.DBName = "db"
+
+		dsn := cfg.FormatDSN()
+		if want := "user:" + passwd + "@tcp(localhost:3306)/db"; dsn != want {
+			t.Errorf("FormatDSN() = %q, want %q", dsn, want)
+		}
+		parsed, err := ParseDSN(dsn)
+		if err != nil {
+			t.Fatalf("ParseDSN(%q): %v", dsn, err)
+		}
+		if parsed.User != "user" || parsed.Passwd != passwd || parsed.Addr != "localhost:3306" || parsed.DBName != "db" {
+			t.Errorf("ParseDSN(%q) = user %q, password %q, addr %q, dbname %q", dsn, parsed.User, parsed.Passwd, parsed.Addr, parsed.DBName)
+		}
+	}
+}
+
+func FuzzFormatDSN(f *testing.F) {
+	f.Add("user", "pass:w@rd/", "tcp", "localhost:3306", "db/name?", "utf8mb4,utf8", "utf8mb4_bin", "service", "a,b:c", "time_zone", "SET @a = 'x,y&z=%'", uint8(1), 64<<20, 10, 8, true)
+	f.Add("", "", "unix", "/tmp/mysql.sock", "", "", "", "", "", "", "", uint8(0), 0, 0, 0, false)
+	for _, passwd := range []string{"p@ss", "p/ss", "p:ss", "@/:", ":@tcp(h)/", "%40%2F", "p@ss/word:?x=y"} {
+		f.Add("user", passwd, "tcp", "localhost:3306", "db", "", "", "", "", "", "", uint8(0), 0, 0, 0, false)
+		f.Add("", passwd, "unix", "/tmp/mysql.sock", "", "", "", "", "", "", "", uint8(2), 0, 0, 0, true)
+	}
+
+	f.Fuzz(func(t *testing.T, user, passwd, network, addr, dbname, charsets, collation, attrKey, attrValue, sysVar, initCommand string,
+		loc uint8, maxAllowedPacket, fetchSize, stmtCacheSize int, flag bool) {
+		// the DSN syntax can't represent these values
+		if strings.Contains(user, ":") ||
+			network == "" || strings.ContainsAny(network, "/@(") ||
+			strings.ContainsAny(addr, "@") ||
+			strings.ContainsAny(charsets, "&=/") || strings.Contains(charsets, ",,") ||
+			strings.HasPrefix(charsets, ",") || strings.HasSuffix(charsets, ",") {
+			t.Skip()
+		}
+		if _, ok := collations[collation]; !ok && collation != "" {
+			t.Skip()
+		}
+		if slices.ContainsFunc(strings.Split(charsets, ","), func(c string) bool { return c != "" && !isCharset(c) }) {
+			t.Skip()
+		}
+		if maxAllowedPacket < 0 || fetchSize < 0 || stmtCacheSize < 0 {
+			t.Skip()
+		}
+
+		cfg := NewConfig()
+		cfg.User = user
+		cfg.Passwd = passwd
+		cfg.Net = network
+		cfg.Addr = addr
+		cfg.DBName = dbname
+		cfg.Collation = collation
+		cfg.Loc = []*time.Location{nil, time.UTC, time.Local}[loc%3]
+		cfg.MaxAllowedPacket = maxAllowedPacket
+		cfg.FetchSize = fetchSize
+		cfg.StmtCacheSize = stmtCacheSize
+		cfg.InterpolateParams = flag
+		cfg.ParseTime = !flag
+		cfg.DecodeCharsets = flag
+		cfg.FetchWarnings = !flag
+		cfg.KillQueryOnCancel = flag
+		cfg.DeadlineToServer = !flag
+		if charsets != "" {
+			cfg.charsets = strings.Split(charsets, ",")
+		}
+		if attrKey != "" {
+			cfg.ConnectionAttributes = map[string]string{attrKey: attrValue}
+		}
+		if sysVar != "" {
+			cfg.Params = map[string]string{sysVar: attrValue}
+		}
+		if initCommand != "" {
+			cfg.InitCommands = []string{initCommand, "DO 1"}
+		}
+		if err := cfg.normalize(); err != nil {
+			t.Skip()
+		}
+
+		dsn := cfg.FormatDSN()
+		parsed, err := ParseDSN(dsn)
+		if err != nil {
+			t.Fatalf("ParseDSN(%q): %v", dsn, err)
+		}
+		if !reflect.DeepEqual(cfg, parsed) {
+			t.Fatalf("%q does not round-trip:\ngot  %+v\nwant %+v", dsn, parsed, cfg)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package mysqldriver
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrorDetails holds what the message of a constraint violation error says
+// about its cause. Fields the message does not mention are empty.
+type ErrorDetails struct {
+	// Key is the violated index of a duplicate entry error, e.g. "PRIMARY".
+	Key string
+	// Value is the duplicated value, as formatted by the server. The
+	// columns of a composite key are separated by '-' and long values are
+	// truncated.
+	Value string
+
+	// Column is the column of a NULL or data too long error.
+	Column string
+	// Row is the 1-based row number of a data too long error.
+	Row int
+
+	// Schema and Table name the table the statement failed on. MySQL 8.0
+	// qualifies the key of duplicate entry errors with the table, older
+	// versions and MariaDB don't; foreign key errors report the child table.
+	Schema string
+	Table  string
+
+	// Constraint, Columns, ReferencedSchema, ReferencedTable and
+	// ReferencedColumns describe the violated foreign key. The message is
+	// truncated on some versions, so the referenced parts may be missing.
+	Constraint        string
+	Columns           []string
+	ReferencedSchema  string
+	ReferencedTable   string
+	ReferencedColumns []string
+}
+
+const quotedIdentRE = "`(?:[^`]|``)*`"
+
+var (
+	quotedIdentListRE = regexp.MustCompile(quotedIdentRE)
+
+	// (`schema`.`child`, CONSTRAINT `fk` FOREIGN KEY (`a`, `b`) REFERENCES `parent` (`x`, `y`) ON DELETE CASCADE)
+	fkTableRE      = regexp.MustCompile("\\((?:(" + quotedIdentRE + ")\\.)?(" + quotedIdentRE + "), CONSTRAINT (" + quotedIdentRE + ")")
+	fkReferencesRE = regexp.MustCompile("^ FOREIGN KEY \\(([^)]*)\\) REFERENCES (?:(" + quotedIdentRE + ")\\.)?(" + quotedIdentRE + ") \\(([^)]*)\\)")
+
+	// Data too long for column 'name' at row 1
+	dataTooLongRE = regexp.MustCompile(`^Data too long for column '(.*)' at row (\d+)$`)
+)
+
+// Details parses the message of duplicate entry (1062, 1586), foreign key (1451,
+// 1452), NULL (1048) and data too long (1406) errors. It returns false for
+// other errors and for messages in an unknown format.
+func (me *MySQLError) Details() (*ErrorDetails, bool) {
+	var d ErrorDetails
+	switch ErrorCode(me.Number) {
+	case ErDupEntry, ErDupEntryWithKeyName:
+		// Duplicate entry 'value' for key 'table.key'
+		const prefix, sep = "Duplicate entry '", "' for key '"
+		rest, ok := strings.CutPrefix(me.Message, prefix)
+		if !ok || !strings.HasSuffix(rest, "'") {
+			return nil, false
+		}
+		// the value may contain the separator, the key name is quoted
+		i := strings.LastIndex(rest, sep)
+		if i < 0 {
+			return nil, false
+		}
+		d.Value = rest[:i]
+		d.Key = rest[i+len(sep) : len(rest)-1]
+		if table, key, ok := strings.Cut(d.Key, "."); ok {
+			d.Table, d.Key = table, key
+		}
+
+	case ErRowIsReferenced2, ErNoReferencedRow2:
+		// ... a foreign key constraint fails (`schema`.`child`, CONSTRAINT ...)
+		loc := fkTableRE.FindStringSubmatchIndex(me.Message)
+		if loc == nil {
+			return nil, false
+		}
+		m := me.Message
+		if loc[2] >= 0 {
+			d.Schema = unquoteIdent(m[loc[2]:loc[3]])
+		}
+		d.Table = unquoteIdent(m[loc[4]:loc[5]])
+		d.Constraint = unquoteIdent(m[loc[6]:loc[7]])
+		if refs := fkReferencesRE.FindStringSubmatch(m[loc[1]:]); refs != nil {
+			d.Columns = splitIdentList(refs[1])
+			d.ReferencedSchema = unquoteIdent(refs[2])
+			d.ReferencedTable = unquoteIdent(refs[3])
+			d.ReferencedColumns = splitIdentList(refs[4])
+		}
+
+	case ErBadNullError:
+		// Column 'name' cannot be null
+		rest, ok := strings.CutPrefix(me.Message, "Column '")
+		if !ok {
+			return nil, false
+		}
+		if d.Column, ok = strings.CutSuffix(rest, "' cannot be null"); !ok {
+			return nil, false
+		}
+
+	case ErDataTooLong:
+		m := dataTooLongRE.FindStringSubmatch(me.Message)
+		if m == nil {
+			return nil, false
+		}
+		d.Column = m[1]
+		d.Row, _ = strconv.Atoi(m[2])
+
+	default:
+		return nil, false
+	}
+	return &d, true
+}
+
+// unquoteIdent removes the backticks around a quoted identifier. It returns
+// "" for "".
+func unquoteIdent(s string) string {
+	if len(s) < 2 {
+		return ""
+	}
+	return strings.ReplaceAll(s[1:len(s)-1], "``", "`")
+}
+
+// splitIdentList splits a list of quoted identifiers like "`a`, `b`".
+func splitIdentList(s string) []string {
+	idents := quotedIdentListRE.FindAllString(s, -1)
+	for i, ident := range idents {
+		idents[i] = unquoteIdent(ident)
+	}
+	return idents
+}
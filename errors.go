@@ -1,6 +1,43 @@
 package mysqldriver
 
-import "fmt"
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// errcodes.go is generated from the error messages of the MySQL and MariaDB
+// sources pinned in gen_errcodes.go, which are fetched with "go mod download".
+//
+//go:generate go run gen_errcodes.go
+
+// Various errors the driver might return. Can change between driver versions.
+var (
+	ErrInvalidConn   = errors.New("invalid connection")
+	ErrMalformPkt    = errors.New("malformed packet")
+	ErrUnknownPlugin = errors.New("this authentication plugin is not supported")
+	ErrOldProtocol   = errors.New("MySQL server does not support required protocol 41+")
+	ErrPktSync       = errors.New("commands out of sync. You can't run this command now")
+	ErrPktSyncMul    = errors.New("commands out of sync. Did you run multiple statements at once?")
+	ErrBusyBuffer    = errors.New("busy buffer")
+
+	// ErrPktTooLarge is returned, wrapped with the sizes involved, when a
+	// packet or a long data parameter would exceed max_allowed_packet.
+	// Nothing has been sent in that case, so the connection remains usable.
+	ErrPktTooLarge = errors.New("packet for query is too large. Try adjusting the `Config.MaxAllowedPacket`")
+)
+
+// noWriteError is returned by writePacket if the connection failed before
+// any byte of the packet was written. markBadConn turns it into
+// driver.ErrBadConn.
+type noWriteError struct {
+	err error
+}
+
+func (e *noWriteError) Error() string { return e.err.Error() }
+
+func (e *noWriteError) Unwrap() error { return e.err }
 
 type MySQLError struct {
 	Number   uint16
@@ -14,3 +51,90 @@ func (me *MySQLError) Error() string {
 	}
 	return fmt.Sprintf("Error %d: %s", me.Number, me.Message)
 }
+
+// Is reports whether err is an ErrorCode or a *MySQLError with the same
+// number, so errors.Is(err, ErDupEntry) matches duplicate key errors.
+func (me *MySQLError) Is(err error) bool {
+	switch target := err.(type) {
+	case ErrorCode:
+		return uint16(target) == me.Number
+	case *MySQLError:
+		return target.Number == me.Number
+	}
+	return false
+}
+
+// ErrorCode is a server error number. The codes the driver knows are listed
+// in errcodes.go.
+type ErrorCode uint16
+
+func (c ErrorCode) Error() string {
+	if info, ok := errorCodes[c]; ok {
+		return fmt.Sprintf("%s (%d)", info.name, uint16(c))
+	}
+	return fmt.Sprintf("server error %d", uint16(c))
+}
+
+// errorClass is a set of the classifications reported by the Is* helpers.
+type errorClass uint8
+
+const (
+	errClassDuplicateKey errorClass = 1 << iota
+	errClassDeadlock
+	errClassLockWaitTimeout
+	errClassReadOnly
+	errClassRetryable
+)
+
+type errorCodeInfo struct {
+	name    string
+	classes errorClass
+}
+
+// hasErrorClass reports whether err wraps a *MySQLError whose number
+// belongs to class.
+func hasErrorClass(err error, class errorClass) bool {
+	var me *MySQLError
+	if !errors.As(err, &me) {
+		return false
+	}
+	return errorCodes[ErrorCode(me.Number)].classes&class != 0
+}
+
+// IsDuplicateKey reports whether err is a unique or primary key violation.
+func IsDuplicateKey(err error) bool {
+	return hasErrorClass(err, errClassDuplicateKey)
+}
+
+// IsDeadlock reports whether err is a deadlock the server resolved by
+// rolling back the transaction.
+func IsDeadlock(err error) bool {
+	return hasErrorClass(err, errClassDeadlock)
+}
+
+// IsLockWaitTimeout reports whether err is a timeout waiting for a row lock
+// (innodb_lock_wait_timeout).
+func IsLockWaitTimeout(err error) bool {
+	return hasErrorClass(err, errClassLockWaitTimeout)
+}
+
+// IsReadOnly reports whether err was caused by writing to a read-only
+// server or in a read-only transaction, e.g. on a replica after a failover.
+func IsReadOnly(err error) bool {
+	var me *MySQLError
+	if !errors.As(err, &me) {
+		return false
+	}
+	if ErrorCode(me.Number) == ErOptionPreventsStatement {
+		// also returned for other options, e.g. --secure-file-priv
+		return strings.Contains(me.Message, "read-only") || strings.Contains(me.Message, "read_only")
+	}
+	return hasErrorClass(err, errClassReadOnly)
+}
+
+// IsRetryable reports whether the failed statement or transaction may
+// succeed if it is run again, either because the server aborted it because
+// of contention or because the driver did not send it at all.
+func IsRetryable(err error) bool {
+	return errors.Is(err, driver.ErrBadConn) || hasErrorClass(err, errClassRetryable)
+}
@@ -0,0 +1,196 @@
+package mysqldriver
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestMySQLErrorIs(t *testing.T) {
+	err := fmt.Errorf("insert user: %w", &MySQLError{Number: 1062, Message: "Duplicate entry '1' for key 'users.PRIMARY'"})
+	if !errors.Is(err, ErDupEntry) {
+		t.Errorf("errors.Is(%v, ErDupEntry) = false", err)
+	}
+	if !errors.Is(err, &MySQLError{Number: 1062}) {
+		t.Errorf("errors.Is(%v, &MySQLError{Number: 1062}) = false", err)
+	}
+	if errors.Is(err, ErLockDeadlock) {
+		t.Errorf("errors.Is(%v, ErLockDeadlock) = true", err)
+	}
+	if got, want := ErDupEntry.Error(), "ER_DUP_ENTRY (1062)"; got != want {
+		t.Errorf("ErDupEntry.Error() = %q, want %q", got, want)
+	}
+}
+
+func TestErrorClassification(t *testing.T) {
+	tests := []struct {
+		err                                                      error
+		duplicateKey, deadlock, lockWaitTimeout, readOnly, retry bool
+	}{
+		{err: &MySQLError{Number: 1062}, duplicateKey: true},
+		{err: &MySQLError{Number: 1586}, duplicateKey: true},
+		{err: &MySQLError{Number: 1213}, deadlock: true, retry: true},
+		{err: &MySQLError{Number: 1205}, lockWaitTimeout: true, retry: true},
+		{err: &MySQLError{Number: 1836}, readOnly: true},
+		{err: &MySQLError{Number: 1290, Message: "The MySQL server is running with the --read-only option so it cannot execute this statement"}, readOnly: true},
+		{err: &MySQLError{Number: 1290, Message: "The MySQL server is running with the --secure-file-priv option so it cannot execute this statement"}},
+		{err: &MySQLError{Number: 1064}},
+		{err: fmt.Errorf("%w: %w", driver.ErrBadConn, errors.New("broken pipe")), retry: true},
+		{err: ErrMalformPkt},
+		{err: nil},
+	}
+	for i, tst := range tests {
+		if got := IsDuplicateKey(tst.err); got != tst.duplicateKey {
+			t.Errorf("%d. IsDuplicateKey(%v) = %v", i, tst.err, got)
+		}
+		if got := IsDeadlock(tst.err); got != tst.deadlock {
+			t.Errorf("%d. IsDeadlock(%v) = %v", i, tst.err, got)
+		}
+		if got := IsLockWaitTimeout(tst.err); got != tst.lockWaitTimeout {
+			t.Errorf("%d. IsLockWaitTimeout(%v) = %v", i, tst.err, got)
+		}
+		if got := IsReadOnly(tst.err); got != tst.readOnly {
+			t.Errorf("%d. IsReadOnly(%v) = %v", i, tst.err, got)
+		}
+		if got := IsRetryable(tst.err); got != tst.retry {
+			t.Errorf("%d. IsRetryable(%v) = %v", i, tst.err, got)
+		}
+	}
+}
+
+func TestMySQLErrorDetails(t *testing.T) {
+	tests := []struct {
+		server string
+		err    *MySQLError
+		want   *ErrorDetails
+	}{{
+		"MySQL 8.0",
+		&MySQLError{Number: 1062, Message: "Duplicate entry 'alice@example.com' for key 'users.uniq_email'"},
+		&ErrorDetails{Table: "users", Key: "uniq_email", Value: "alice@example.com"},
+	}, {
+		"MySQL 5.7",
+		&MySQLError{Number: 1062, Message: "Duplicate entry 'alice@example.com' for key 'uniq_email'"},
+		&ErrorDetails{Key: "uniq_email", Value: "alice@example.com"},
+	}, {
+		"MariaDB 10.6",
+		&MySQLError{Number: 1062, Message: "Duplicate entry '7-2' for key 'PRIMARY'"},
+		&ErrorDetails{Key: "PRIMARY", Value: "7-2"},
+	}, {
+		"MySQL 8.0",
+		&MySQLError{Number: 1062, Message: "Duplicate entry 'O' for key 'x' for key 'people.name'"},
+		&ErrorDetails{Table: "people", Key: "name", Value: "O' for key 'x"},
+	}, {
+		"MySQL 8.0",
+		&MySQLError{Number: 1451, Message: "Cannot delete or update a parent row: a foreign key constraint fails (`shop`.`orders`, CONSTRAINT `orders_ibfk_1` FOREIGN KEY (`customer_id`) REFERENCES `customers` (`id`))"},
+		&ErrorDetails{Schema: "shop", Table: "orders", Constraint: "orders_ibfk_1", Columns: []string{"customer_id"}, ReferencedTable: "customers", ReferencedColumns: []string{"id"}},
+	}, {
+		"MariaDB 10.6",
+		&MySQLError{Number: 1452, Message: "Cannot add or update a child row: a foreign key constraint fails (`shop`.`order_items`, CONSTRAINT `fk_item` FOREIGN KEY (`order_id`, `line`) REFERENCES `archive`.`lines` (`order_id`, `line`) ON DELETE CASCADE ON UPDATE CASCADE)"},
+		&ErrorDetails{Schema: "shop", Table: "order_items", Constraint: "fk_item", Columns: []string{"order_id", "line"}, ReferencedSchema: "archive", ReferencedTable: "lines", ReferencedColumns: []string{"order_id", "line"}},
+	}, {
+		"MySQL 5.7, truncated",
+		&MySQLError{Number: 1452, Message: "Cannot add or update a child row: a foreign key constraint fails (`shop`.`odd``name`, CONSTRAINT `fk_customer` FOREIGN KEY (`customer_id`) REFERENCES `custo"},
+		&ErrorDetails{Schema: "shop", Table: "odd`name", Constraint: "fk_customer"},
+	}, {
+		"MySQL 8.0",
+		&MySQLError{Number: 1048, Message: "Column 'email' cannot be null"},
+		&ErrorDetails{Column: "email"},
+	}, {
+		"MariaDB 10.6",
+		&MySQLError{Number: 1406, Message: "Data too long for column 'name' at row 3"},
+		&ErrorDetails{Column: "name", Row: 3},
+	}}
+	for i, tst := range tests {
+		got, ok := tst.err.Details()
+		if !ok {
+			t.Errorf("%d. %s: Details(%q) failed", i, tst.server, tst.err.Message)
+			continue
+		}
+		if !reflect.DeepEqual(got, tst.want) {
+			t.Errorf("%d. %s: Details(%q):\ngot  %+v\nwant %+v", i, tst.server, tst.err.Message, got, tst.want)
+		}
+	}
+
+	for _, err := range []*MySQLError{
+		{Number: 1064, Message: "You have an error in your SQL syntax"},
+		{Number: 1062, Message: "Duplicate entry '1' for key 2"},
+		{Number: 1451, Message: "Cannot delete or update a parent row: a foreign key constraint fails"},
+	} {
+		if d, ok := err.Details(); ok {
+			t.Errorf("Details(%q) = %+v, want failure", err.Message, d)
+		}
+	}
+}
+
+func TestMySQLWarnings(t *testing.T) {
+	var err error = MySQLWarnings{
+		{Level: "Warning", Code: 1265, Message: "Data truncated for column 'name' at row 1"},
+		{Level: "Note", Code: 1051, Message: "Unknown table 'test.t'"},
+	}
+	want := "Warning 1265: Data truncated for column 'name' at row 1\nNote 1051: Unknown table 'test.t'"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	var warnings MySQLWarnings
+	if !errors.As(fmt.Errorf("load: %w", err), &warnings) || len(warnings) != 2 {
+		t.Errorf("errors.As failed: %v", warnings)
+	}
+}
+
+// failingConn accepts limit bytes, then fails every write with err.
+type failingConn struct {
+	mockConn
+	limit int
+	err   error
+}
+
+func (c *failingConn) Write(b []byte) (int, error) {
+	n := min(len(b), c.limit)
+	c.limit -= n
+	c.written.Write(b[:n])
+	if n < len(b) {
+		return n, c.err
+	}
+	return n, nil
+}
+
+func TestMarkBadConn(t *testing.T) {
+	errWrite := errors.New("broken pipe")
+	tests := []struct {
+		name        string
+		limit       int
+		err         error
+		queryLen    int
+		wantBadConn bool
+	}{
+		{"before any write", 0, errWrite, 10, true},
+		{"partial write", 3, errWrite, 10, false},
+		{"second packet", 4 + maxPacketSize, errWrite, maxPacketSize + 10, false},
+		// the deadline of the context passed, retrying wouldn't help
+		{"deadline", 0, os.ErrDeadlineExceeded, 10, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nc := &failingConn{limit: tt.limit, err: tt.err}
+			mc := newMockConnData()
+			mc.netConn, mc.rawConn = nc, nc
+			mc.maxAllowedPacket = 2 * maxPacketSize
+
+			query := "SELECT '" + strings.Repeat("x", tt.queryLen-9) + "'"
+			_, err := mc.query(query, nil)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("got %v, want %v", err, tt.err)
+			}
+			if got := errors.Is(err, driver.ErrBadConn); got != tt.wantBadConn {
+				t.Errorf("errors.Is(%v, driver.ErrBadConn) = %v, want %v", err, got, tt.wantBadConn)
+			}
+			if !mc.closed.Load() {
+				t.Error("the connection is still open")
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package mysqldriver
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestTypeDatabaseName(t *testing.T) {
+	tests := []struct {
+		field mysqlField
+		want  string
+	}{
+		{mysqlField{fieldType: fieldTypeTiny}, "TINYINT"},
+		{mysqlField{fieldType: fieldTypeTiny, flags: flagUnsigned}, "UNSIGNED TINYINT"},
+		{mysqlField{fieldType: fieldTypeLongLong, flags: flagUnsigned}, "UNSIGNED BIGINT"},
+		{mysqlField{fieldType: fieldTypeNewDecimal}, "DECIMAL"},
+		{mysqlField{fieldType: fieldTypeBLOB, charSet: 33}, "TEXT"},
+		{mysqlField{fieldType: fieldTypeBLOB, charSet: binaryCollationID}, "BLOB"},
+		{mysqlField{fieldType: fieldTypeLongBLOB, charSet: 255}, "LONGTEXT"},
+		{mysqlField{fieldType: fieldTypeVarString, charSet: 255}, "VARCHAR"},
+		{mysqlField{fieldType: fieldTypeVarString, charSet: binaryCollationID}, "VARBINARY"},
+		{mysqlField{fieldType: fieldTypeString, charSet: binaryCollationID}, "BINARY"},
+		// ENUM and SET columns are reported as strings with a flag
+		{mysqlField{fieldType: fieldTypeString, flags: flagEnum, charSet: 255}, "ENUM"},
+		{mysqlField{fieldType: fieldTypeString, flags: flagSet, charSet: 255}, "SET"},
+		{mysqlField{fieldType: fieldTypeJSON, charSet: binaryCollationID}, "JSON"},
+		{mysqlField{fieldType: fieldTypeVector}, "VECTOR"},
+		{mysqlField{fieldType: 0xf0}, ""},
+	}
+	for _, tt := range tests {
+		if got := tt.field.typeDatabaseName(); got != tt.want {
+			t.Errorf("typeDatabaseName(type %d, flags %#x, charset %d) = %q, want %q",
+				tt.field.fieldType, tt.field.flags, tt.field.charSet, got, tt.want)
+		}
+	}
+}
+
+func TestScanType(t *testing.T) {
+	tests := []struct {
+		field mysqlField
+		want  reflect.Type
+	}{
+		{mysqlField{fieldType: fieldTypeTiny, flags: flagNotNULL}, scanTypeInt8},
+		{mysqlField{fieldType: fieldTypeTiny, flags: flagNotNULL | flagUnsigned}, scanTypeUint8},
+		{mysqlField{fieldType: fieldTypeTiny}, scanTypeNullInt},
+		{mysqlField{fieldType: fieldTypeYear, flags: flagNotNULL | flagUnsigned}, scanTypeUint16},
+		{mysqlField{fieldType: fieldTypeInt24, flags: flagNotNULL}, scanTypeInt32},
+		{mysqlField{fieldType: fieldTypeLongLong, flags: flagNotNULL | flagUnsigned}, scanTypeUint64},
+		{mysqlField{fieldType: fieldTypeLongLong}, scanTypeNullInt},
+		{mysqlField{fieldType: fieldTypeFloat, flags: flagNotNULL}, scanTypeFloat32},
+		{mysqlField{fieldType: fieldTypeDouble}, scanTypeNullFloat},
+		{mysqlField{fieldType: fieldTypeNewDecimal, flags: flagNotNULL}, scanTypeString},
+		{mysqlField{fieldType: fieldTypeVarString, charSet: 255}, scanTypeNullString},
+		{mysqlField{fieldType: fieldTypeVarString, charSet: 255, flags: flagNotNULL}, scanTypeString},
+		{mysqlField{fieldType: fieldTypeBLOB, charSet: binaryCollationID}, scanTypeRawBytes},
+		{mysqlField{fieldType: fieldTypeDateTime, flags: flagNotNULL}, scanTypeNullTime},
+		{mysqlField{fieldType: fieldTypeNULL}, scanTypeUnknown},
+	}
+	for _, tt := range tests {
+		if got := tt.field.scanType(); got != tt.want {
+			t.Errorf("scanType(type %d, flags %#x, charset %d) = %v, want %v",
+				tt.field.fieldType, tt.field.flags, tt.field.charSet, got, tt.want)
+		}
+	}
+}
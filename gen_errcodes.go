@@ -0,0 +1,268 @@
+//go:build ignore
+
+// gen_errcodes generates errcodes.go from errmsg-utf8.txt, the file the
+// server build turns into mysqld_ername.h with comp_err:
+//
+//	go run gen_errcodes.go [-mysql errmsg-utf8.txt] [-mariadb errmsg-utf8.txt]
+//
+// By default, the files are read from the MySQL and MariaDB sources pinned in
+// mysqlSource and mariadbSource, which "go mod download" fetches through the
+// module proxy. The sources are GPL licensed and are not copied into this
+// repository. MySQL 8.0 renamed the file to share/messages_to_clients.txt,
+// which has the same format and can be passed with -mysql.
+//
+// All ER_* errors of MySQL are exported, except for the placeholders of
+// removed errors. ER_* errors of MariaDB are added if
+// MySQL uses neither their name nor their code. MariaDB codes from 4000 on
+// are skipped, as MySQL 8.0 assigns them to other errors. Codes from 10000
+// on are only written to the error log of the server and are skipped too.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const (
+	mysqlSource   = "github.com/mysql/mysql-server@v0.0.0-20230920192705-f7680e98b6bb" // 5.7.44
+	mariadbSource = "github.com/MariaDB/server@v0.0.0-20260508100530-b067340c34cb"     // 11.8.7
+	errmsgFile    = "sql/share/errmsg-utf8.txt"
+
+	// firstErrorLogCode is the first code of the messages for the error log.
+	firstErrorLogCode = 10000
+	// firstMariaDBOnlyCode is the first code of the MariaDB errors which
+	// MySQL 8.0 uses for different errors.
+	firstMariaDBOnlyCode = 4000
+)
+
+// newerErrors are MySQL 8.0 errors exported by the driver that are missing
+// from mysqlSource. Remove them when moving the source to MySQL 8.0.
+var newerErrors = map[string]int{
+	"ER_LOCK_NOWAIT": 3572,
+}
+
+// classes are the classes the helpers in errors.go report errors as.
+var classes = map[string]string{
+	"ER_DUP_ENTRY":                             "errClassDuplicateKey",
+	"ER_DUP_UNIQUE":                            "errClassDuplicateKey",
+	"ER_DUP_ENTRY_WITH_KEY_NAME":               "errClassDuplicateKey",
+	"ER_LOCK_WAIT_TIMEOUT":                     "errClassLockWaitTimeout | errClassRetryable",
+	"ER_LOCK_DEADLOCK":                         "errClassDeadlock | errClassRetryable",
+	"ER_XA_RBDEADLOCK":                         "errClassDeadlock | errClassRetryable",
+	"ER_TOO_MANY_CONCURRENT_TRXS":              "errClassRetryable",
+	"ER_TRANSACTION_ROLLBACK_DURING_COMMIT":    "errClassRetryable",
+	"ER_CANT_EXECUTE_IN_READ_ONLY_TRANSACTION": "errClassReadOnly",
+	"ER_READ_ONLY_MODE":                        "errClassReadOnly",
+}
+
+// nameRE matches the name starting a message, e.g. ER_DUP_ENTRY.
+var nameRE = regexp.MustCompile(`^[A-Z][A-Z0-9_]*$`)
+
+func main() {
+	mysqlFile := flag.String("mysql", "", "errmsg-utf8.txt of MySQL (default: from "+mysqlSource+")")
+	mariadbFile := flag.String("mariadb", "", "errmsg-utf8.txt of MariaDB (default: from "+mariadbSource+")")
+	out := flag.String("o", "errcodes.go", "output file")
+	flag.Parse()
+
+	if *mysqlFile == "" {
+		*mysqlFile = download(mysqlSource)
+	}
+	if *mariadbFile == "" {
+		*mariadbFile = download(mariadbSource)
+	}
+	mysql := readErrmsg(*mysqlFile)
+	mariadb := readErrmsg(*mariadbFile)
+
+	type entry struct {
+		name, ident, classes string
+		code                 int
+		mariadb              bool
+	}
+	var entries []entry
+	idents := make(map[string]string)
+	add := func(name string, code int, mariadb bool) {
+		ident := goName(name)
+		if other, ok := idents[ident]; ok {
+			log.Fatalf("%s and %s are both named %s", name, other, ident)
+		}
+		idents[ident] = name
+		entries = append(entries, entry{name, ident, classes[name], code, mariadb})
+	}
+
+	// codes of all MySQL messages, including warnings and obsolete errors
+	mysqlCodes := make(map[int]bool)
+	for name, code := range mysql {
+		mysqlCodes[code] = true
+		if strings.HasPrefix(name, "ER_") && !unused(name) && code < firstErrorLogCode {
+			add(name, code, false)
+		}
+	}
+	for name, code := range newerErrors {
+		if _, ok := mysql[name]; ok || mysqlCodes[code] {
+			log.Fatalf("%s is in %s, remove it from newerErrors", name, *mysqlFile)
+		}
+		mysqlCodes[code] = true
+		add(name, code, false)
+	}
+	for name, code := range mariadb {
+		if _, ok := mysql[name]; ok || newerErrors[name] != 0 || mysqlCodes[code] ||
+			!strings.HasPrefix(name, "ER_") || unused(name) || code >= firstMariaDBOnlyCode {
+			continue
+		}
+		add(name, code, true)
+	}
+	for name := range classes {
+		if _, ok := idents[goName(name)]; !ok {
+			log.Fatalf("%s is not a known error", name)
+		}
+	}
+	slices.SortFunc(entries, func(a, b entry) int { return a.code - b.code })
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "// Code generated by gen_errcodes.go from %s of MySQL %s and MariaDB %s; DO NOT EDIT.\n\n",
+		errmsgFile, version(*mysqlFile), version(*mariadbFile))
+	buf.WriteString("package mysqldriver\n\n")
+	buf.WriteString("// Server error codes. A *MySQLError matches its code with errors.Is:\n")
+	buf.WriteString("//\n//\tif errors.Is(err, mysqldriver.ErDupEntry) { ... }\n//\n")
+	buf.WriteString("// Codes only MariaDB sends are marked as such.\n")
+	buf.WriteString("//\n")
+	buf.WriteString("// https://dev.mysql.com/doc/mysql-errors/8.0/en/server-error-reference.html\n")
+	buf.WriteString("// https://mariadb.com/kb/en/mariadb-error-code-reference/\n")
+	buf.WriteString("const (\n")
+	for _, e := range entries {
+		comment := e.name
+		if e.mariadb {
+			comment += " (MariaDB)"
+		}
+		fmt.Fprintf(&buf, "\t%s ErrorCode = %d // %s\n", e.ident, e.code, comment)
+	}
+	buf.WriteString(")\n\n")
+	buf.WriteString("var errorCodes = map[ErrorCode]errorCodeInfo{\n")
+	for _, e := range entries {
+		classes := e.classes
+		if classes == "" {
+			classes = "0"
+		}
+		fmt.Fprintf(&buf, "\t%s: {%q, %s},\n", e.ident, e.name, classes)
+	}
+	buf.WriteString("}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// download fetches the source tree of module@version into the module cache
+// and returns the path of its errmsg-utf8.txt.
+func download(source string) string {
+	cmd := exec.Command("go", "mod", "download", "-json", source)
+	// not in this module, so go.mod and go.sum stay unchanged
+	cmd.Dir = os.TempDir()
+	cmd.Stderr = os.Stderr
+	data, err := cmd.Output()
+	var mod struct {
+		Dir   string
+		Error string
+	}
+	if jsonErr := json.Unmarshal(data, &mod); jsonErr != nil || mod.Error != "" {
+		log.Fatalf("go mod download %s: %v %s", source, err, mod.Error)
+	}
+	return filepath.Join(mod.Dir, errmsgFile)
+}
+
+// readErrmsg returns the codes of the messages in an errmsg-utf8.txt file.
+// Like comp_err, it numbers the messages consecutively from the code set by
+// the last start-error-number or skip-to-error-number line.
+func readErrmsg(path string) map[string]int {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	codes := make(map[string]int)
+	code := -1
+	for i, line := range strings.Split(string(data), "\n") {
+		// messages in the various languages are indented
+		if line == "" || line[0] == '#' || line[0] == ' ' || line[0] == '\t' {
+			continue
+		}
+		// comp_err ends words at these delimiters too
+		fields := strings.FieldsFunc(line, func(r rune) bool { return strings.ContainsRune(" \t\r,;=", r) })
+		switch fields[0] {
+		case "start-error-number", "skip-to-error-number":
+			if len(fields) < 2 {
+				log.Fatalf("%s:%d: missing error number", path, i+1)
+			}
+			if code, err = strconv.Atoi(fields[1]); err != nil {
+				log.Fatalf("%s:%d: %v", path, i+1, err)
+			}
+			continue
+		case "languages", "default-language", "reserved-error-section":
+			continue
+		}
+		if !nameRE.MatchString(fields[0]) {
+			log.Fatalf("%s:%d: unexpected line %q", path, i+1, line)
+		}
+		if code < 0 {
+			log.Fatalf("%s:%d: %s precedes start-error-number", path, i+1, fields[0])
+		}
+		if _, ok := codes[fields[0]]; ok {
+			log.Fatalf("%s:%d: %s defined twice", path, i+1, fields[0])
+		}
+		codes[fields[0]] = code
+		code++
+	}
+	return codes
+}
+
+// version returns the server version from the VERSION file of the source
+// tree holding errmsg, or "(unknown version)".
+func version(errmsg string) string {
+	data, err := os.ReadFile(filepath.Join(filepath.Dir(errmsg), "../../VERSION"))
+	if err != nil {
+		return "(unknown version)"
+	}
+	var parts [3]string
+	for _, line := range strings.Split(string(data), "\n") {
+		k, v, _ := strings.Cut(line, "=")
+		switch k {
+		case "MYSQL_VERSION_MAJOR":
+			parts[0] = v
+		case "MYSQL_VERSION_MINOR":
+			parts[1] = v
+		case "MYSQL_VERSION_PATCH":
+			parts[2] = v
+		}
+	}
+	return strings.Join(parts[:], ".")
+}
+
+// unused reports whether name is a placeholder keeping the code of a removed
+// error, such as ER_UNUSED_17 or ER_NO_SUCH_PARTITION__UNUSED.
+func unused(name string) bool {
+	return strings.Contains(name, "UNUSED")
+}
+
+// goName turns ER_DUP_ENTRY into ErDupEntry.
+func goName(name string) string {
+	var sb strings.Builder
+	for _, part := range strings.Split(name, "_") {
+		sb.WriteString(part[:1])
+		sb.WriteString(strings.ToLower(part[1:]))
+	}
+	return sb.String()
+}
@@ -2,61 +2,121 @@ package mysqldriver
 
 import (
 	"bytes"
+	"context"
 	"database/sql/driver"
 	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"os"
+	"strings"
 )
 
+// https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_connection_phase_packets_protocol_handshake_v10.html
 func (mc *mysqlConn) readHandshakePacket() (data []byte, plugin string, err error) {
 	data, err = mc.readPacket()
 	if err != nil {
 		return
 	}
+	if len(data) == 0 {
+		return nil, "", ErrMalformPkt
+	}
 
-	pos := 1 + bytes.IndexByte(data[1:], 0x00) + 1 + 4
+	// the server refuses the connection, e.g. with 1040 "Too many
+	// connections" or 1129 "Host is blocked"
+	if data[0] == iERR {
+		return nil, "", mc.handleErrorPacket(data)
+	}
 
-	// first part of password cipher
-	authData := data[pos : pos+8]
+	// protocol version [1 byte]
+	if data[0] < minProtocolVersion {
+		return nil, "", fmt.Errorf("%w: handshake protocol version %d, version %d is required",
+			ErrOldProtocol, data[0], minProtocolVersion)
+	}
 
-	// filter
+	tooShort := func() error {
+		return fmt.Errorf("%w: handshake packet of %d bytes is too short", ErrMalformPkt, len(data))
+	}
+
+	// server version [null terminated string]
+	end := bytes.IndexByte(data[1:], 0x00)
+	if end < 0 {
+		return nil, "", tooShort()
+	}
+	mc.server = ServerInfo{}
+	mc.server.parseServerVersion(string(data[1 : 1+end]))
+	pos := 1 + end + 1
+
+	// connection id [4 bytes]
+	// first part of password cipher [8 bytes]
+	// filler [1 byte]
+	// capability flags (lower 2 bytes) [2 bytes]
+	if len(data) < pos+4+8+1+2 {
+		return nil, "", tooShort()
+	}
+	mc.server.ThreadID = binary.LittleEndian.Uint32(data[pos : pos+4])
+	pos += 4
+
+	authData := make([]byte, 0, 20)
+	authData = append(authData, data[pos:pos+8]...)
 	pos += 8 + 1
 
-	// capability flags
+	serverFlags := clientFlag(binary.LittleEndian.Uint16(data[pos : pos+2]))
+	if serverFlags&clientProtocol41 == 0 {
+		return nil, "", ErrOldProtocol
+	}
 	pos += 2
 
 	if len(data) > pos {
+		// character set [1 byte]
+		// status flags [2 bytes]
+		// capability flags (upper 2 bytes) [2 bytes]
+		// length of auth-plugin-data [1 byte]
+		// reserved [10 bytes]
+		// second part of password cipher [12 bytes + NUL]
+		if len(data) < pos+1+2+2+1+10+12 {
+			return nil, "", tooShort()
+		}
 
-		// character set
-		pos += 1
-		// status flags
-		pos += 2
-		// capability flags
+		mc.serverCollation = data[pos]
+		pos++
+		mc.server.Status = binary.LittleEndian.Uint16(data[pos : pos+2])
 		pos += 2
-		// auth-plugin-data
-		pos += 1
-		// reserved
-		pos += 10
+		serverFlags |= clientFlag(binary.LittleEndian.Uint16(data[pos:pos+2])) << 16
+		pos += 2 + 1 + 10
 
-		// second part of password cipher
 		authData = append(authData, data[pos:pos+12]...)
 		pos += 13
 
-		if end := bytes.IndexByte(data[pos:], 0x00); end != -1 {
-			plugin = string(data[pos : pos+end])
-		} else {
-			plugin = string(data[pos:])
+		// auth plugin name [null terminated string]
+		if pos < len(data) {
+			if end := bytes.IndexByte(data[pos:], 0x00); end != -1 {
+				plugin = string(data[pos : pos+end])
+			} else {
+				plugin = string(data[pos:])
+			}
 		}
+	}
 
-		var b [20]byte
-		copy(b[:], authData)
-		return b[:], plugin, nil
+	mc.server.CollationID = mc.serverCollation
+	mc.server.Capabilities = uint32(serverFlags)
+	// until the handshake response is sent
+	mc.flags = serverFlags
+	return authData, plugin, nil
+}
+
+// ioError returns the error to report for a failed read or write on the
+// network connection.
+func (mc *mysqlConn) ioError(err error) error {
+	if cerr := mc.canceled.Value(); cerr != nil {
+		return cerr
+	}
+	// the deadline set by watchCancel passed
+	if errors.Is(err, os.ErrDeadlineExceeded) {
+		return fmt.Errorf("%w: %w", context.DeadlineExceeded, err)
 	}
-	var b [8]byte
-	copy(b[:], authData)
-	return b[:], plugin, nil
+	return err
 }
 
 // MySQL client/server protocol documentations.
@@ -69,10 +129,7 @@ func (mc *mysqlConn) readPacket() ([]byte, error) {
 		data, err := readNext(4, mc.readWithTimeout)
 		if err != nil {
 			mc.close()
-			if cerr := mc.canceled.Value(); cerr != nil {
-				return nil, cerr
-			}
-			return nil, err
+			return nil, mc.ioError(err)
 		}
 
 		// packet length
@@ -81,7 +138,11 @@ func (mc *mysqlConn) readPacket() ([]byte, error) {
 
 		// packet sequence
 		if seq != mc.sequence {
-			return nil, errors.New("commands out of sync.")
+			mc.close()
+			if seq > mc.sequence {
+				return nil, ErrPktSyncMul
+			}
+			return nil, ErrPktSync
 		}
 		mc.sequence++
 
@@ -89,10 +150,7 @@ func (mc *mysqlConn) readPacket() ([]byte, error) {
 		data, err = readNext(pkLen, mc.readWithTimeout)
 		if err != nil {
 			mc.close()
-			if cerr := mc.canceled.Value(); cerr != nil {
-				return nil, cerr
-			}
-			return nil, err
+			return nil, mc.ioError(err)
 		}
 
 		// check if the packet is complete
@@ -118,13 +176,16 @@ func (mc *mysqlConn) readAuthResult() ([]byte, string, error) {
 	case iAuthMoreData:
 		return data[1:], "", err
 	default:
-		return nil, "", errors.New("malformed packet")
+		return nil, "", ErrMalformPkt
 	}
 }
 
 // http://dev.mysql.com/doc/internals/en/connection-phase-packets.html#packet-Protocol::HandshakeResponse
 func (mc *mysqlConn) writeHandshakeResponsePacket(authResp []byte, plugin string) error {
-	clientFlags := clientProtocol41 |
+	// request the capabilities the driver supports, as far as the server
+	// supports them too
+	serverFlags := clientFlag(mc.server.Capabilities)
+	clientFlags := (clientProtocol41 |
 		clientSecureConn |
 		clientLongPassword |
 		clientTransactions |
@@ -132,17 +193,35 @@ func (mc *mysqlConn) writeHandshakeResponsePacket(authResp []byte, plugin string
 		clientPluginAuth |
 		clientMultiResults |
 		clientConnectAttrs |
-		clientLongFlag
-
-	sendConnectAttrs := true
-
-	var authRespLEIBuf [9]byte
-	authRespLen := len(authResp)
-	authRespLEI := appendLengthEncodedInteger(authRespLEIBuf[:0], uint64(authRespLen))
+		clientLongFlag |
+		clientDeprecateEOF |
+		clientSessionTrack) & serverFlags
+
+	sendConnectAttrs := clientFlags&clientConnectAttrs != 0
+
+	// auth response [length encoded with CLIENT_SECURE_CONNECTION,
+	// null terminated otherwise]
+	var authRespLEI []byte
+	if clientFlags&clientSecureConn != 0 {
+		var authRespLEIBuf [9]byte
+		authRespLEI = appendLengthEncodedInteger(authRespLEIBuf[:0], uint64(len(authResp)))
+		if len(authRespLEI) > 1 {
+			// only the length encoding understands lengths above 250
+			if serverFlags&clientPluginAuthLenEncClientData == 0 {
+				return fmt.Errorf("auth response of %d bytes is too long for the server", len(authResp))
+			}
+			clientFlags |= clientPluginAuthLenEncClientData
+		}
+	} else {
+		authRespLEI = []byte{}
+	}
 
-	pktLen := 4 + 4 + 1 + 23 + len(mc.cfg.User) + 1 + len(authRespLEI) + len(authResp) + 21 + 1
+	pktLen := 4 + 4 + 1 + 23 + len(mc.cfg.User) + 1 + len(authRespLEI) + len(authResp) + 1 + len(plugin) + 1
 
 	if n := len(mc.cfg.DBName); n > 0 {
+		if serverFlags&clientConnectWithDB == 0 {
+			return errors.New("the server does not support selecting a database when connecting")
+		}
 		clientFlags |= clientConnectWithDB
 		pktLen += n + 1
 	}
@@ -165,7 +244,12 @@ func (mc *mysqlConn) writeHandshakeResponsePacket(authResp []byte, plugin string
 
 	binary.LittleEndian.PutUint32(data[8:], 0)
 
-	data[12] = defaultCollationID
+	// Collation ID [1 byte]
+	data[12], err = mc.handshakeCollation()
+	if err != nil {
+		mc.cleanup()
+		return err
+	}
 
 	pos := 13
 	for ; pos < 13+23; pos++ {
@@ -180,6 +264,10 @@ func (mc *mysqlConn) writeHandshakeResponsePacket(authResp []byte, plugin string
 
 	pos += copy(data[pos:], authRespLEI)
 	pos += copy(data[pos:], authResp)
+	if clientFlags&clientSecureConn == 0 {
+		data[pos] = 0x00
+		pos++
+	}
 
 	if len(mc.cfg.DBName) > 0 {
 		pos += copy(data[pos:], mc.cfg.DBName)
@@ -187,24 +275,62 @@ func (mc *mysqlConn) writeHandshakeResponsePacket(authResp []byte, plugin string
 		pos++
 	}
 
-	pos += copy(data[pos:], plugin)
-	data[pos] = 0x00
-	pos++
+	if clientFlags&clientPluginAuth != 0 {
+		pos += copy(data[pos:], plugin)
+		data[pos] = 0x00
+		pos++
+	}
 
 	if sendConnectAttrs {
 		pos += copy(data[pos:], connAttrsLEI)
 		pos += copy(data[pos:], []byte(mc.connector.encodedAttributes))
 	}
 
+	// from now on only the capabilities both sides agreed on are in effect
+	mc.flags = clientFlags
+
 	return mc.writePacket(data[:pos])
 }
 
+// handshakeCollation returns the ID of the collation requested in the
+// handshake response. Collations with an ID that doesn't fit into the single
+// byte are set by handleParams after the connection is established.
+func (mc *mysqlConn) handshakeCollation() (byte, error) {
+	cname := mc.cfg.Collation
+	if cname == "" {
+		// use the default collation of the server if it is a utf8mb4 one,
+		// e.g. utf8mb4_0900_ai_ci on MySQL 8.0
+		if name, ok := collationNames[uint16(mc.serverCollation)]; ok && collationCharset(name) == "utf8mb4" {
+			mc.charset = "utf8mb4"
+			return mc.serverCollation, nil
+		}
+		cname = defaultCollation
+	}
+
+	id, found := collations[cname]
+	if !found {
+		// Note possibility for false negatives:
+		// could be triggered  although the collation is valid if the
+		// collations map does not contain entries the server supports.
+		return 0, fmt.Errorf("unknown collation: %q", cname)
+	}
+	mc.charset = collationCharset(cname)
+	if id > 0xff {
+		return byte(collations[defaultCollation]), nil
+	}
+	return byte(id), nil
+}
+
 func (mc *mysqlConn) writePacket(data []byte) error {
 	pktLen := len(data) - 4
 
+	if pktLen > mc.maxAllowedPacket {
+		return fmt.Errorf("%w: %d bytes exceed max_allowed_packet of %d bytes", ErrPktTooLarge, pktLen, mc.maxAllowedPacket)
+	}
+
 	writeFunc := mc.writeWithTimeout
 
-	for {
+	for first := true; ; first = false {
 		size := min(maxPacketSize, pktLen)
 		putUint24(data[:3], size)
 		data[3] = mc.sequence
@@ -212,14 +338,14 @@ func (mc *mysqlConn) writePacket(data []byte) error {
 		n, err := writeFunc(data[:4+size])
 		if err != nil {
 			mc.cleanup()
-			if cerr := mc.canceled.Value(); cerr != nil {
-				return cerr
+			if first && n == 0 && !errors.Is(err, os.ErrDeadlineExceeded) && mc.canceled.Value() == nil {
+				return &noWriteError{err}
 			}
-			return err
+			return mc.ioError(err)
 		}
 		if n != 4+size {
 			mc.cleanup()
-			return errors.New("short write")
+			return io.ErrShortWrite
 		}
 
 		mc.sequence++
@@ -263,12 +389,14 @@ func (mc *okHandler) readResultOK() error {
 	if data[0] == iOK {
 		return mc.handleOkPacket(data)
 	}
-	return errors.New("malformed packet")
+	return ErrMalformPkt
 }
 
 func (mc *okHandler) readResultSetHeaderPacket() (int, error) {
 	mc.result.affectedRows = append(mc.result.affectedRows, 0)
 	mc.result.insertIds = append(mc.result.insertIds, 0)
+	mc.result.warningCounts = append(mc.result.warningCounts, 0)
+	mc.result.infos = append(mc.result.infos, "")
 
 	data, err := mc.conn().readPacket()
 	if err != nil {
@@ -278,10 +406,15 @@ func (mc *okHandler) readResultSetHeaderPacket() (int, error) {
 	switch data[0] {
 	case iOK:
 		return 0, mc.handleOkPacket(data)
+	case iERR:
+		return 0, mc.conn().handleErrorPacket(data)
 	}
 
 	// https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_query_response_text_resultset.html
-	num, _, _ := readLengthEncodedInteger(data)
+	num, _, n := readLengthEncodedInteger(data)
+	if n > len(data) {
+		return 0, ErrMalformPkt
+	}
 	return int(num), nil
 }
 
@@ -292,14 +425,67 @@ func (mc *okHandler) handleOkPacket(data []byte) error {
 
 	// Affected rows [Length Coded Binary]
 	affectedRows, _, n = readLengthEncodedInteger(data[1:])
+	if 1+n > len(data) {
+		return ErrMalformPkt
+	}
 	// Insert id [Length Coded Binary]
 	insertId, _, m = readLengthEncodedInteger(data[1+n:])
 
-	// TODO
-	_ = affectedRows
-	_ = insertId
+	pos := 1 + n + m
+	if len(data) < pos+4 {
+		return ErrMalformPkt
+	}
 
-	mc.status = readStatus(data[1+n+m : 1+n+m+2])
+	// Update for the current statement result (only used by
+	// readResultSetHeaderPacket).
+	if len(mc.result.affectedRows) > 0 {
+		mc.result.affectedRows[len(mc.result.affectedRows)-1] = int64(affectedRows)
+	}
+	if len(mc.result.insertIds) > 0 {
+		mc.result.insertIds[len(mc.result.insertIds)-1] = int64(insertId)
+	}
+
+	// server_status [2 bytes]
+	mc.status = readStatus(data[pos : pos+2])
+	pos += 2
+
+	// warning count [2 bytes]
+	warnings := binary.LittleEndian.Uint16(data[pos : pos+2])
+	pos += 2
+	if len(mc.result.warningCounts) > 0 {
+		mc.result.warningCounts[len(mc.result.warningCounts)-1] = warnings
+	}
+
+	var info []byte
+	if mc.flags&clientSessionTrack != 0 {
+		// the info field may be omitted if it is empty and the session
+		// state did not change
+		if pos < len(data) {
+			// info [len coded string]
+			var err error
+			if info, _, n, err = readLengthEncodedString(data[pos:]); err != nil {
+				return err
+			}
+			pos += n
+
+			// session state info [len coded string]
+			if mc.status&statusSessionStateChanged != 0 {
+				state, _, _, err := readLengthEncodedString(data[pos:])
+				if err != nil {
+					return err
+				}
+				if err = mc.session.parse(state); err != nil {
+					return err
+				}
+			}
+		}
+	} else if pos < len(data) {
+		// info [string<EOF>]
+		info = data[pos:]
+	}
+	if len(info) > 0 && len(mc.result.infos) > 0 {
+		mc.result.infos[len(mc.result.infos)-1] = string(info)
+	}
 
 	return nil
 }
@@ -312,7 +498,7 @@ func (mc *okHandler) discardResults() error {
 		}
 		if resLen > 0 {
 			// columns
-			if err := mc.conn().readUntilEOF(); err != nil {
+			if err := mc.conn().skipColumns(resLen); err != nil {
 				return err
 			}
 			// rows
@@ -328,8 +514,11 @@ func readStatus(b []byte) statusFlag {
 	return statusFlag(b[0]) | statusFlag(b[1])<<8
 }
 
+// clearResult is called when a command starts. It discards the result and
+// the session state reported for the previous command.
 func (mc *mysqlConn) clearResult() *okHandler {
 	mc.result = mysqlResult{}
+	mc.session = SessionState{}
 	return (*okHandler)(mc)
 }
 
@@ -346,6 +535,14 @@ func (mc *mysqlConn) writeCommandPacket(command byte) error {
 func (mc *mysqlConn) writeCommandPacketStr(command byte, arg string) error {
 	mc.resetSequence()
 
+	// queries are sent in the connection character set
+	if mc.encoder != nil {
+		var err error
+		if arg, err = mc.encoder.String(arg); err != nil {
+			return err
+		}
+	}
+
 	pktLen := 1 + len(arg)
 	data, err := mc.buf.takeBuffer(pktLen + 4)
 	if err != nil {
@@ -373,10 +570,36 @@ func (mc *mysqlConn) writeCommandPacketUint32(command byte, arg uint32) error {
 	return mc.writePacket(data)
 }
 
+// https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_fetch.html
+func (mc *mysqlConn) writeFetchPacket(stmtID, numRows uint32) error {
+	mc.resetSequence()
+
+	data, err := mc.buf.takeSmallBuffer(4 + 1 + 4 + 4)
+	if err != nil {
+		return err
+	}
+
+	// command [1 byte]
+	data[4] = comStmtFetch
+
+	// statement_id [4 bytes]
+	binary.LittleEndian.PutUint32(data[5:], stmtID)
+
+	// num_rows [4 bytes]
+	binary.LittleEndian.PutUint32(data[9:], numRows)
+
+	return mc.writePacket(data)
+}
+
 // https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_query_response_text_resultset_column_definition.html#sect_protocol_com_query_response_text_resultset_column_definition_41
 func (mc *mysqlConn) readColumns(count int) ([]mysqlField, error) {
 	columns := make([]mysqlField, count)
 	for i := 0; ; i++ {
+		// with CLIENT_DEPRECATE_EOF no EOF packet follows the column definitions
+		if i == count && mc.flags&clientDeprecateEOF != 0 {
+			return columns, nil
+		}
+
 		data, err := mc.readPacket()
 		if err != nil {
 			return nil, err
@@ -384,10 +607,13 @@ func (mc *mysqlConn) readColumns(count int) ([]mysqlField, error) {
 
 		if data[0] == iEOF && (len(data) == 5 || len(data) == 1) {
 			if i == count {
-				return columns, nil
+				return columns, mc.handleEOFPacket(data)
 			}
 			return nil, fmt.Errorf("column count mismatch n:%d len:%d", count, len(columns))
 		}
+		if i == count {
+			return nil, fmt.Errorf("column count mismatch n:%d len:%d", count, i+1)
+		}
 
 		// Catalog
 		pos, err := skipLengthEncodedString(data)
@@ -395,28 +621,31 @@ func (mc *mysqlConn) readColumns(count int) ([]mysqlField, error) {
 			return nil, err
 		}
 
-		// Database
-		n, err := skipLengthEncodedString(data[pos:])
+		// Database [len coded string]
+		schema, _, n, err := readLengthEncodedString(data[pos:])
 		if err != nil {
 			return nil, err
 		}
+		columns[i].schema = string(schema)
 		pos += n
 
-		// Table
-		n, err = skipLengthEncodedString(data[pos:])
+		// Table [len coded string]
+		tableName, _, n, err := readLengthEncodedString(data[pos:])
 		if err != nil {
 			return nil, err
 		}
+		columns[i].tableName = string(tableName)
 		pos += n
 
-		// Original table
-		n, err = skipLengthEncodedString(data[pos:])
+		// Original table [len coded string]
+		orgTable, _, n, err := readLengthEncodedString(data[pos:])
 		if err != nil {
 			return nil, err
 		}
+		columns[i].orgTable = string(orgTable)
 		pos += n
 
-		// Name
+		// Name [len coded string]
 		name, _, n, err := readLengthEncodedString(data[pos:])
 		if err != nil {
 			return nil, err
@@ -424,18 +653,19 @@ func (mc *mysqlConn) readColumns(count int) ([]mysqlField, error) {
 		columns[i].name = string(name)
 		pos += n
 
-		// Original name
-		n, err = skipLengthEncodedString(data[pos:])
+		// Original name [len coded string]
+		orgName, _, n, err := readLengthEncodedString(data[pos:])
 		if err != nil {
 			return nil, err
 		}
+		columns[i].orgName = string(orgName)
 		pos += n
 
 		// filter
 		pos++
 
-		// character set
-		columns[i].charSet = data[pos]
+		// character set [2 bytes]
+		columns[i].charSet = binary.LittleEndian.Uint16(data[pos : pos+2])
 		pos += 2
 
 		// column length
@@ -453,6 +683,10 @@ func (mc *mysqlConn) readColumns(count int) ([]mysqlField, error) {
 		// decimals
 		columns[i].decimals = data[pos]
 		pos += 1
+
+		if mc.cfg.DecodeCharsets && columns[i].isTextField() {
+			columns[i].decoder = collationDecoder(columns[i].charSet)
+		}
 	}
 }
 
@@ -469,13 +703,11 @@ func (rows *textRows) readRow(dest []driver.Value) error {
 		return err
 	}
 
-	if data[0] == iEOF && len(data) == 5 {
-		rows.mc.status = readStatus(data[3:])
-		rows.rs.done = true
-		if !rows.HasNextResultSet() {
-			rows.mc = nil
+	if mc.isEOFPacket(data) {
+		if err := mc.handleEOFPacket(data); err != nil {
+			return err
 		}
-		return io.EOF
+		return rows.endResultSet()
 	}
 	if data[0] == iERR {
 		rows.mc = nil
@@ -502,6 +734,14 @@ func (rows *textRows) readRow(dest []driver.Value) error {
 			continue
 		}
 
+		if decoder := rows.rs.columns[i].decoder; decoder != nil {
+			if buf, err = decoder.Bytes(buf); err != nil {
+				return err
+			}
+			dest[i] = string(buf)
+			continue
+		}
+
 		switch rows.rs.columns[i].fieldType {
 		default:
 			dest[i] = buf
@@ -513,20 +753,43 @@ func (rows *textRows) readRow(dest []driver.Value) error {
 
 // https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_binary_resultset.html#sect_protocol_binary_resultset_row
 func (rows *binaryRows) readRow(dest []driver.Value) error {
-	data, err := rows.mc.readPacket()
-	if err != nil {
-		return err
+	var data []byte
+	var err error
+	for {
+		if rows.pending != nil {
+			data, rows.pending = rows.pending, nil
+		} else {
+			// request the next batch of rows from the cursor
+			if rows.fetchSize > 0 && !rows.fetching {
+				if err = rows.mc.writeFetchPacket(rows.stmtID, rows.fetchSize); err != nil {
+					return err
+				}
+				rows.fetching = true
+			}
+			if data, err = rows.mc.readPacket(); err != nil {
+				return err
+			}
+		}
+		if !rows.mc.isEOFPacket(data) {
+			break
+		}
+
+		if err := rows.mc.handleEOFPacket(data); err != nil {
+			return err
+		}
+		if !rows.fetching {
+			return rows.endResultSet()
+		}
+		// the batch ended, fetch the next one unless it was the last
+		rows.endBatch()
+		if rows.lastRowSent {
+			// the server closed the cursor after the last row
+			rows.fetchSize = 0
+			return rows.endResultSet()
+		}
 	}
 
 	if data[0] != iOK {
-		if data[0] == iEOF && len(data) == 5 {
-			rows.mc.status = readStatus(data[3:])
-			rows.rs.done = true
-			if !rows.HasNextResultSet() {
-				rows.mc = nil
-			}
-			return io.EOF
-		}
 		mc := rows.mc
 		rows.mc = nil
 		return mc.handleErrorPacket(data)
@@ -548,7 +811,7 @@ func (rows *binaryRows) readRow(dest []driver.Value) error {
 			if rows.rs.columns[i].flags&flagUnsigned != 0 {
 				dest[i] = int64(data[pos])
 			} else {
-				dest[i] = uint64(int8(data[pos]))
+				dest[i] = int64(int8(data[pos]))
 			}
 			pos++
 			continue
@@ -556,7 +819,7 @@ func (rows *binaryRows) readRow(dest []driver.Value) error {
 			if rows.rs.columns[i].flags&flagUnsigned != 0 {
 				dest[i] = int64(binary.LittleEndian.Uint16(data[pos : pos+2]))
 			} else {
-				dest[i] = uint64(int16(binary.LittleEndian.Uint16(data[pos : pos+2])))
+				dest[i] = int64(int16(binary.LittleEndian.Uint16(data[pos : pos+2])))
 			}
 			pos += 2
 			continue
@@ -576,8 +839,17 @@ func (rows *binaryRows) readRow(dest []driver.Value) error {
 			fieldTypeVector:
 			var isNull bool
 			var n int
-			dest[i], isNull, n, err = readLengthEncodedString(data[pos:])
+			var buf []byte
+			buf, isNull, n, err = readLengthEncodedString(data[pos:])
 			pos += n
+			if err == nil && !isNull {
+				if decoder := rows.rs.columns[i].decoder; decoder != nil {
+					buf, err = decoder.Bytes(buf)
+					dest[i] = string(buf)
+				} else {
+					dest[i] = buf
+				}
+			}
 			if err == nil {
 				if !isNull {
 					continue
@@ -594,6 +866,8 @@ func (rows *binaryRows) readRow(dest []driver.Value) error {
 	return nil
 }
 
+// readUntilEOF discards packets up to and including the EOF packet, or the
+// OK packet that replaces it when CLIENT_DEPRECATE_EOF is in effect.
 func (mc *mysqlConn) readUntilEOF() error {
 	for {
 		data, err := mc.readPacket()
@@ -601,33 +875,89 @@ func (mc *mysqlConn) readUntilEOF() error {
 			return err
 		}
 
-		switch data[0] {
-		case iERR:
+		switch {
+		case data[0] == iERR:
 			return mc.handleErrorPacket(data)
-		case iEOF:
-			if len(data) == 5 {
-				mc.status = readStatus(data[3:])
-			}
-			return nil
+		case mc.isEOFPacket(data):
+			return mc.handleEOFPacket(data)
+		}
+	}
+}
+
+// skipColumns discards count column definition packets. Without
+// CLIENT_DEPRECATE_EOF they are terminated by an EOF packet instead.
+func (mc *mysqlConn) skipColumns(count int) error {
+	if mc.flags&clientDeprecateEOF == 0 {
+		return mc.readUntilEOF()
+	}
+	for range count {
+		data, err := mc.readPacket()
+		if err != nil {
+			return err
+		}
+		if data[0] == iERR {
+			return mc.handleErrorPacket(data)
+		}
+	}
+	return nil
+}
+
+// isEOFPacket reports whether data terminates a sequence of rows or columns.
+// https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_basic_eof_packet.html
+func (mc *mysqlConn) isEOFPacket(data []byte) bool {
+	if data[0] != iEOF {
+		return false
+	}
+	if mc.flags&clientDeprecateEOF != 0 {
+		// A text row may also start with 0xfe if its first value is a string
+		// with a length prefix of 8 bytes, but such a row is at least
+		// maxPacketSize bytes long.
+		return len(data) < maxPacketSize
+	}
+	return len(data) <= 5
+}
+
+// handleEOFPacket updates the connection status from an EOF packet or, with
+// CLIENT_DEPRECATE_EOF, from the OK packet with an 0xfe header replacing it.
+func (mc *mysqlConn) handleEOFPacket(data []byte) error {
+	if mc.flags&clientDeprecateEOF != 0 {
+		return mc.resultUnchanged().handleOkPacket(data)
+	}
+	// warnings [2 bytes]
+	// server_status [2 bytes]
+	if len(data) == 5 {
+		if n := len(mc.result.warningCounts); n > 0 {
+			mc.result.warningCounts[n-1] = binary.LittleEndian.Uint16(data[1:3])
 		}
+		mc.status = readStatus(data[3:])
 	}
+	return nil
 }
 
 // https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_basic_err_packet.html
 func (mc *mysqlConn) handleErrorPacket(data []byte) error {
-	if data[0] != iERR {
-		return errors.New("malformed packet")
+	if len(data) < 3 || data[0] != iERR {
+		return ErrMalformPkt
 	}
 	errno := binary.LittleEndian.Uint16(data[1:3])
 	me := &MySQLError{
 		Number: errno,
 	}
 	pos := 3
-	if data[3] == 0x23 {
+	// SQL state marker and state [1 + 5 bytes], missing in errors sent
+	// before the handshake
+	if len(data) >= 9 && data[3] == 0x23 {
 		copy(me.SQLState[:], data[4:4+5])
 		pos = 9
 	}
 	me.Message = string(data[pos:])
+
+	// report the cancellation instead of the interruption caused by it
+	if ErrorCode(me.Number) == ErQueryInterrupted && mc.killed.Load() {
+		if err := mc.killedErr.Value(); err != nil {
+			return err
+		}
+	}
 	return me
 }
 
@@ -655,7 +985,7 @@ func (stmt *mysqlStmt) readPrepareResultPacket() (uint16, error) {
 }
 
 // https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_execute.html
-func (stmt *mysqlStmt) writeExecutePacket(args []driver.Value) error {
+func (stmt *mysqlStmt) writeExecutePacket(args []driver.Value, cursorType byte) error {
 	if len(args) != stmt.paramCount {
 		return fmt.Errorf(
 			"argument count mismatch (got: %d; has: %d)",
@@ -672,6 +1002,23 @@ func (stmt *mysqlStmt) writeExecutePacket(args []driver.Value) error {
 		longDataSize = 64
 	}
 
+	// Check the sizes first, the server would only reject a value after
+	// receiving all of its long data.
+	for i, arg := range args {
+		var n int
+		switch v := arg.(type) {
+		case string:
+			n = len(v)
+		case []byte:
+			n = len(v)
+		case json.RawMessage:
+			n = len(v)
+		}
+		if n > mc.maxAllowedPacket {
+			return fmt.Errorf("%w: parameter %d is %d bytes, max_allowed_packet is %d bytes", ErrPktTooLarge, i, n, mc.maxAllowedPacket)
+		}
+	}
+
 	mc.resetSequence()
 
 	var data []byte
@@ -693,8 +1040,8 @@ func (stmt *mysqlStmt) writeExecutePacket(args []driver.Value) error {
 	// statement_id [4 bytes]
 	binary.LittleEndian.PutUint32(data[5:], stmt.id)
 
-	// flags CURSOR_TYPE_NO_CURSOR [1 byte]
-	data[9] = 0x00
+	// flags [1 byte]
+	data[9] = cursorType
 
 	// iteration_count [4 bytes]
 	binary.LittleEndian.PutUint32(data[10:], 1)
@@ -732,7 +1079,7 @@ func (stmt *mysqlStmt) writeExecutePacket(args []driver.Value) error {
 		for i, arg := range args {
 			if arg == nil {
 				nullMask[i/8] |= 1 << (uint(i) & 7)
-				paramTypes[i+1] = byte(fieldTypeNULL)
+				paramTypes[i+i] = byte(fieldTypeNULL)
 				paramTypes[i+i+1] = 0x00
 				continue
 			}
@@ -741,6 +1088,12 @@ func (stmt *mysqlStmt) writeExecutePacket(args []driver.Value) error {
 				arg = []byte(v)
 			}
 
+			if v, ok := arg.(string); ok && mc.encoder != nil {
+				if arg, err = mc.encoder.String(v); err != nil {
+					return err
+				}
+			}
+
 			switch v := arg.(type) {
 			case string:
 				paramTypes[i+i] = byte(fieldTypeString)
@@ -749,10 +1102,28 @@ func (stmt *mysqlStmt) writeExecutePacket(args []driver.Value) error {
 					paramValues = appendLengthEncodedInteger(paramValues, uint64(len(v)))
 					paramValues = append(paramValues, v...)
 				} else {
-					if err := stmt.writeCommandLongData(i, []byte(v)); err != nil {
+					if err := stmt.writeCommandLongData(i, strings.NewReader(v)); err != nil {
+						return err
+					}
+				}
+			case []byte:
+				paramTypes[i+i] = byte(fieldTypeString)
+				paramTypes[i+i+1] = 0x00
+				if len(v) < longDataSize {
+					paramValues = appendLengthEncodedInteger(paramValues, uint64(len(v)))
+					paramValues = append(paramValues, v...)
+				} else {
+					if err := stmt.writeCommandLongData(i, bytes.NewReader(v)); err != nil {
 						return err
 					}
 				}
+			case io.Reader:
+				// always streamed, the size is not known in advance
+				paramTypes[i+i] = byte(fieldTypeString)
+				paramTypes[i+i+1] = 0x00
+				if err := stmt.writeCommandLongData(i, v); err != nil {
+					return err
+				}
 			default:
 				return fmt.Errorf("cannot convert type: %T", arg)
 			}
@@ -773,44 +1144,80 @@ func (stmt *mysqlStmt) writeExecutePacket(args []driver.Value) error {
 }
 
 // https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_send_long_data.html
-func (stmt *mysqlStmt) writeCommandLongData(paramID int, arg []byte) error {
-	maxLen := stmt.mc.maxAllowedPacket - 1
-	pktLen := maxLen
-
-	// 4 bytes for the packet header
+//
+// writeCommandLongData streams r to the server in packets of up to
+// longDataChunkSize bytes without reading it into memory as a whole. If r
+// fails, the long data sent so far is discarded with COM_STMT_RESET.
+func (stmt *mysqlStmt) writeCommandLongData(paramID int, r io.Reader) error {
 	// 1 byte for the command
 	// 4 bytes for the statement ID
 	// 2 bytes for the parameter ID
 	const dataOffset = 1 + 4 + 2
 
-	data := make([]byte, 4+dataOffset+len(arg))
-
-	copy(data[4+dataOffset:], arg)
+	// Packets are not filled up to max_allowed_packet as with other long
+	// data: a chunk is allocated for every io.Reader parameter, which would
+	// take 64 MiB with the default maxAllowedPacket. 1 MiB chunks only add
+	// packet headers on large values and keep the memory bounded.
+	chunkSize := min(stmt.mc.maxAllowedPacket-1-dataOffset, longDataChunkSize)
+	if l, ok := r.(interface{ Len() int }); ok && l.Len() < chunkSize {
+		// bytes.Reader, strings.Reader and bytes.Buffer know their size
+		chunkSize = l.Len()
+	}
 
-	for argLen := len(arg); argLen > 0; argLen -= pktLen - dataOffset {
-		if dataOffset+argLen < maxLen {
-			pktLen = dataOffset + argLen
-		}
+	// Cannot use the write buffer since it holds the execute packet
+	data := make([]byte, 4+dataOffset+chunkSize)
 
-		stmt.mc.resetSequence()
+	// 1 byte for the command
+	data[4] = comStmtSendLongData
 
-		// 1 byte for the command
-		data[4] = comStmtSendLongData
+	// 4 bytes for the statement ID
+	binary.LittleEndian.PutUint32(data[5:], stmt.id)
 
-		// 4 bytes for the statement ID
-		binary.LittleEndian.PutUint32(data[5:], stmt.id)
+	// 2 bytes for the parameter ID
+	binary.LittleEndian.PutUint16(data[9:], uint16(paramID))
+
+	// at least one packet is sent, so an empty reader becomes an empty value
+	total := 0
+	for sent := false; ; sent = true {
+		n, err := io.ReadFull(r, data[4+dataOffset:])
+		last := n < chunkSize || chunkSize == 0
+		if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+			if rerr := stmt.mc.resetStmt(stmt.id); rerr != nil {
+				return rerr
+			}
+			return fmt.Errorf("cannot read long data of parameter %d: %w", paramID, err)
+		}
 
-		// 2 bytes for the parameter ID
-		binary.LittleEndian.PutUint16(data[9:], uint16(paramID))
+		// the server limits the value as a whole, not each packet
+		if total += n; total > stmt.mc.maxAllowedPacket {
+			if rerr := stmt.mc.resetStmt(stmt.id); rerr != nil {
+				return rerr
+			}
+			return fmt.Errorf("%w: parameter %d exceeds max_allowed_packet of %d bytes", ErrPktTooLarge, paramID, stmt.mc.maxAllowedPacket)
+		}
 
-		err := stmt.mc.writePacket(data[:4+pktLen])
-		if err != nil {
-			data = data[pktLen-dataOffset:]
-			continue
+		if n > 0 || !sent {
+			stmt.mc.resetSequence()
+			if err := stmt.mc.writePacket(data[:4+dataOffset+n]); err != nil {
+				return err
+			}
+		}
+		if last {
+			break
 		}
-		return err
 	}
 
 	stmt.mc.resetSequence()
 	return nil
 }
+
+// https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_com_stmt_reset.html
+//
+// resetStmt discards the long data sent for a statement and closes its
+// cursor, if any.
+func (mc *mysqlConn) resetStmt(stmtID uint32) error {
+	if err := mc.writeCommandPacketUint32(comStmtReset, stmtID); err != nil {
+		return err
+	}
+	return mc.clearResult().readResultOK()
+}
@@ -0,0 +1,515 @@
+package mysqldriver
+
+import (
+	"bytes"
+	"context"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"reflect"
+	"strconv"
+	"testing"
+	"testing/iotest"
+	"time"
+)
+
+// mockConn replays the responses in reads and records writes. Like a
+// server waiting for the next command, a Read doesn't cross the end of a
+// response.
+type mockConn struct {
+	reads   [][]byte
+	written bytes.Buffer
+	onRead  func() // called once before the next Read, if set
+}
+
+func (m *mockConn) Read(b []byte) (int, error) {
+	if f := m.onRead; f != nil {
+		m.onRead = nil
+		f()
+	}
+	for len(m.reads) > 0 && len(m.reads[0]) == 0 {
+		m.reads = m.reads[1:]
+	}
+	if len(m.reads) == 0 {
+		return 0, io.EOF
+	}
+	n := copy(b, m.reads[0])
+	m.reads[0] = m.reads[0][n:]
+	return n, nil
+}
+func (m *mockConn) Write(b []byte) (int, error)      { return m.written.Write(b) }
+func (m *mockConn) Close() error                     { return nil }
+func (m *mockConn) LocalAddr() net.Addr              { return nil }
+func (m *mockConn) RemoteAddr() net.Addr             { return nil }
+func (m *mockConn) SetDeadline(time.Time) error      { return nil }
+func (m *mockConn) SetReadDeadline(time.Time) error  { return nil }
+func (m *mockConn) SetWriteDeadline(time.Time) error { return nil }
+
+// packets returns the payloads of the packets written so far.
+func (m *mockConn) packets() [][]byte {
+	var pkts [][]byte
+	for b := m.written.Bytes(); len(b) >= 4; {
+		n := getUint24(b)
+		pkts = append(pkts, b[4:4+n])
+		b = b[4+n:]
+	}
+	return pkts
+}
+
+// mockResponse frames payloads as consecutive packets, the first one with
+// sequence number seq.
+func mockResponse(seq byte, payloads ...[]byte) []byte {
+	var data []byte
+	for _, payload := range payloads {
+		data = append(data, byte(len(payload)), byte(len(payload)>>8), byte(len(payload)>>16), seq)
+		data = append(data, payload...)
+		seq++
+	}
+	return data
+}
+
+// newMockConnData returns a connection reading the given responses, which
+// hold framed packets, e.g. built by mockResponse.
+func newMockConnData(responses ...[]byte) *mysqlConn {
+	nc := &mockConn{reads: responses}
+	return &mysqlConn{
+		buf:              newBuffer(),
+		netConn:          nc,
+		rawConn:          nc,
+		cfg:              NewConfig(),
+		maxAllowedPacket: maxPacketSize,
+	}
+}
+
+// newMockConn returns a connection reading payload as a single packet.
+func newMockConn(payload []byte) *mysqlConn {
+	return newMockConnData(mockResponse(0, payload))
+}
+
+// mockColumn returns a column definition of a result set.
+func mockColumn(name string, typ fieldType, flags fieldFlag, charset uint16) []byte {
+	var b []byte
+	for _, s := range []string{"def", "test", "t", "t", name, name} {
+		b = appendLengthEncodedString(b, s)
+	}
+	b = append(b, 0x0c, byte(charset), byte(charset>>8), 0xff, 0, 0, 0, byte(typ), byte(flags), byte(flags>>8), 0, 0, 0)
+	return b
+}
+
+// mockTextRow returns a row of a text protocol result set.
+func mockTextRow(values ...string) []byte {
+	var b []byte
+	for _, v := range values {
+		b = appendLengthEncodedString(b, v)
+	}
+	return b
+}
+
+// mockEOF is an EOF packet without warnings.
+func mockEOF(status statusFlag) []byte {
+	return []byte{iEOF, 0, 0, byte(status), byte(status >> 8)}
+}
+
+// mockOKEOF is the OK packet replacing an EOF packet with
+// CLIENT_DEPRECATE_EOF.
+func mockOKEOF(status statusFlag) []byte {
+	return []byte{iEOF, 0, 0, byte(status), byte(status >> 8), 0, 0}
+}
+
+// MySQL 8.0 greeting with caching_sha2_password
+var testGreeting = bytes.Join([][]byte{
+	{10},                       // protocol version
+	[]byte("8.0.36\x00"),       // server version
+	{0x0b, 0x00, 0x00, 0x00},   // connection id
+	[]byte("abcdefgh"),         // auth-plugin-data-part-1
+	{0x00},                     // filler
+	{0xff, 0xff},               // capability flags (lower 2 bytes)
+	{0xff},                     // character set
+	{0x02, 0x00},               // status flags
+	{0xff, 0xdf},               // capability flags (upper 2 bytes)
+	{21},                       // length of auth-plugin-data
+	make([]byte, 10),           // reserved
+	[]byte("ijklmnopqrst\x00"), // auth-plugin-data-part-2
+	[]byte("caching_sha2_password\x00"),
+}, nil)
+
+func TestReadHandshakePacket(t *testing.T) {
+	mc := newMockConn(testGreeting)
+	authData, plugin, err := mc.readHandshakePacket()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(authData) != "abcdefghijklmnopqrst" {
+		t.Errorf("authData = %q", authData)
+	}
+	if plugin != "caching_sha2_password" {
+		t.Errorf("plugin = %q", plugin)
+	}
+	if mc.serverCollation != 0xff || mc.flags&clientDeprecateEOF == 0 {
+		t.Errorf("serverCollation = %d, flags = %#x", mc.serverCollation, mc.flags)
+	}
+	info := mc.ServerInfo()
+	want := ServerInfo{Version: "8.0.36", Major: 8, Minor: 0, Patch: 36, Flavor: FlavorMySQL, ThreadID: 11,
+		Capabilities: 0xdfffffff, ClientCapabilities: 0xdfffffff, CollationID: 0xff, Status: 2}
+	if info != want {
+		t.Errorf("ServerInfo() = %+v, want %+v", info, want)
+	}
+}
+
+func TestHandshakeResponseFlags(t *testing.T) {
+	mc := newMockConn(nil)
+	mc.connector = &connector{cfg: mc.cfg}
+	mc.server.Capabilities = uint32(clientProtocol41 | clientSecureConn | clientPluginAuth | clientTransactions | clientSessionTrack | clientCompress)
+	if err := mc.writeHandshakeResponsePacket(make([]byte, 20), "mysql_native_password"); err != nil {
+		t.Fatal(err)
+	}
+	want := clientProtocol41 | clientSecureConn | clientPluginAuth | clientTransactions | clientSessionTrack
+	if mc.flags != want {
+		t.Errorf("flags = %#x, want %#x", mc.flags, want)
+	}
+}
+
+func TestHandshakeResponseConnectionAttributes(t *testing.T) {
+	mc := newMockConn(nil)
+	mc.cfg.User = "app"
+	mc.cfg.Addr = "db.example.com:3306"
+	mc.cfg.ConnectionAttributes = map[string]string{"service": "api", connAttrClientName: "billing", "pod": "api-7f9"}
+	mc.connector = newConnector(mc.cfg)
+	mc.server.Capabilities = uint32(clientProtocol41 | clientSecureConn | clientPluginAuth | clientConnectAttrs)
+	if err := mc.writeHandshakeResponsePacket(make([]byte, 20), "mysql_native_password"); err != nil {
+		t.Fatal(err)
+	}
+
+	// skip the flags, max packet size, collation and filler, the user name,
+	// the auth response and the plugin name
+	data := mc.netConn.(*mockConn).packets()[0][4+4+1+23:]
+	data = data[bytes.IndexByte(data, 0)+1:]
+	data = data[1+data[0]:]
+	data = data[bytes.IndexByte(data, 0)+1:]
+
+	block, _, n, err := readLengthEncodedString(data)
+	if err != nil || n != len(data) {
+		t.Fatalf("attribute block of %d bytes in %d bytes: %v", n, len(data), err)
+	}
+	var got [][2]string
+	for len(block) > 0 {
+		k, _, n, err := readLengthEncodedString(block)
+		if err != nil {
+			t.Fatal(err)
+		}
+		v, _, m, err := readLengthEncodedString(block[n:])
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, [2]string{string(k), string(v)})
+		block = block[n+m:]
+	}
+	// _client_name is replaced, not sent twice, and user-defined
+	// attributes follow the predefined ones in sorted order
+	want := [][2]string{
+		{connAttrClientName, "billing"},
+		{connAttrOS, connAttrOSValue},
+		{connAttrPlatform, connAttrPlatformValue},
+		{connAttrPid, strconv.Itoa(os.Getpid())},
+		{connAttrServerHost, "db.example.com"},
+		{"pod", "api-7f9"},
+		{"service", "api"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got attributes %q, want %q", got, want)
+	}
+}
+
+func TestReadHandshakePacketErrors(t *testing.T) {
+	// errors sent before the handshake have no SQL state
+	mc := newMockConn(append([]byte{0xff, 0x10, 0x04}, "Too many connections"...))
+	_, _, err := mc.readHandshakePacket()
+	var me *MySQLError
+	if !errors.As(err, &me) || me.Number != 1040 || me.Message != "Too many connections" {
+		t.Errorf("got %#v, want error 1040", err)
+	}
+
+	mc = newMockConn(append([]byte{0xff, 0x69, 0x04}, "Host '10.0.0.1' is blocked because of many connection errors"...))
+	if _, _, err = mc.readHandshakePacket(); !errors.Is(err, &MySQLError{Number: 1129}) {
+		t.Errorf("got %v, want error 1129", err)
+	}
+
+	mc = newMockConn(append([]byte{9}, "3.20.32a\x00"...))
+	if _, _, err = mc.readHandshakePacket(); !errors.Is(err, ErrOldProtocol) {
+		t.Errorf("protocol 9: got %v, want ErrOldProtocol", err)
+	}
+
+	// a truncated greeting must fail instead of panicking; it may end
+	// after the lower capability flags
+	short := 1 + len("8.0.36\x00") + 4 + 8 + 1 + 2
+	for n := 1; n < len(testGreeting); n++ {
+		mc = newMockConn(testGreeting[:n])
+		_, _, err := mc.readHandshakePacket()
+		if ok := n == short || n >= len(testGreeting)-len("caching_sha2_password\x00")-1; ok != (err == nil) {
+			t.Errorf("%d bytes: got error %v", n, err)
+		}
+	}
+}
+
+func TestHandleErrorPacketKilled(t *testing.T) {
+	errPkt := append([]byte{0xff, 0x25, 0x05, '#', '7', '0', '1', '0', '0'}, "Query execution was interrupted"...)
+
+	mc := newMockConn(nil)
+	if err := mc.handleErrorPacket(errPkt); !errors.Is(err, ErQueryInterrupted) {
+		t.Errorf("got %v, want ErQueryInterrupted", err)
+	}
+
+	// interrupted by killQuery
+	mc.killedErr.Set(context.Canceled)
+	mc.killed.Store(true)
+	if err := mc.handleErrorPacket(errPkt); err != context.Canceled {
+		t.Errorf("got %v, want context.Canceled", err)
+	}
+
+	// the next command resets the flag
+	if err := mc.watchCancel(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := mc.handleErrorPacket(errPkt); !errors.Is(err, ErQueryInterrupted) {
+		t.Errorf("got %v, want ErQueryInterrupted", err)
+	}
+}
+
+// longDataPackets returns the values sent with COM_STMT_SEND_LONG_DATA for
+// statement 1 and the other commands sent.
+func longDataPackets(t *testing.T, mc *mysqlConn) (chunks [][]byte, commands [][]byte) {
+	t.Helper()
+	for _, pkt := range mc.netConn.(*mockConn).packets() {
+		if pkt[0] != comStmtSendLongData {
+			commands = append(commands, pkt)
+			continue
+		}
+		if !bytes.Equal(pkt[1:7], []byte{1, 0, 0, 0, 0, 0}) {
+			t.Errorf("long data header = %x, want statement 1, parameter 0", pkt[1:7])
+		}
+		chunks = append(chunks, pkt[7:])
+	}
+	return chunks, commands
+}
+
+func TestWriteCommandLongData(t *testing.T) {
+	value := bytes.Repeat([]byte("0123456789"), longDataChunkSize/4)
+	mc := newMockConnData()
+	stmt := &mysqlStmt{mc: mc, id: 1, paramCount: 1}
+
+	// the size of the reader is unknown
+	if err := stmt.writeExecutePacket([]driver.Value{io.MultiReader(bytes.NewReader(value))}, cursorTypeNoCursor); err != nil {
+		t.Fatal(err)
+	}
+	chunks, commands := longDataPackets(t, mc)
+	if len(chunks) != 3 || len(chunks[0]) != longDataChunkSize || len(chunks[1]) != longDataChunkSize {
+		t.Errorf("sent %d chunks, want 2 of %d bytes and the rest", len(chunks), longDataChunkSize)
+	}
+	if got := bytes.Join(chunks, nil); !bytes.Equal(got, value) {
+		t.Errorf("sent %d bytes of long data, want %d", len(got), len(value))
+	}
+	if len(commands) != 1 || commands[0][0] != comStmtExecute {
+		t.Errorf("commands = %x, want COM_STMT_EXECUTE", commands)
+	}
+}
+
+func TestWriteCommandLongDataReset(t *testing.T) {
+	errRead := errors.New("read failed")
+	mc := newMockConnData(mockResponse(1, []byte{iOK, 0, 0, 0, 0, 0, 0}))
+	stmt := &mysqlStmt{mc: mc, id: 1, paramCount: 1}
+
+	r := io.MultiReader(bytes.NewReader(make([]byte, longDataChunkSize+10)), iotest.ErrReader(errRead))
+	if err := stmt.writeExecutePacket([]driver.Value{r}, cursorTypeNoCursor); !errors.Is(err, errRead) {
+		t.Fatalf("got %v, want %v", err, errRead)
+	}
+	// the data sent so far is discarded and nothing is executed
+	chunks, commands := longDataPackets(t, mc)
+	if len(chunks) != 1 {
+		t.Errorf("sent %d chunks, want 1", len(chunks))
+	}
+	if len(commands) != 1 || !bytes.Equal(commands[0], []byte{comStmtReset, 1, 0, 0, 0}) {
+		t.Errorf("commands = %x, want COM_STMT_RESET", commands)
+	}
+}
+
+func TestPacketTooLarge(t *testing.T) {
+	mc := newMockConnData()
+	mc.maxAllowedPacket = 10
+	if err := mc.writeCommandPacketStr(comQuery, "SELECT 1234"); !errors.Is(err, ErrPktTooLarge) {
+		t.Errorf("query: got %v, want ErrPktTooLarge", err)
+	}
+
+	// parameters are checked before anything is sent
+	stmt := &mysqlStmt{mc: mc, id: 1, paramCount: 2}
+	for _, arg := range []driver.Value{"0123456789a", []byte("0123456789a"), json.RawMessage(`"123456789"`)} {
+		if err := stmt.writeExecutePacket([]driver.Value{int64(1), arg}, cursorTypeNoCursor); !errors.Is(err, ErrPktTooLarge) {
+			t.Errorf("%T parameter: got %v, want ErrPktTooLarge", arg, err)
+		}
+	}
+
+	if n := mc.netConn.(*mockConn).written.Len(); n != 0 {
+		t.Errorf("%d bytes written", n)
+	}
+	// the connection stays usable
+	if mc.closed.Load() {
+		t.Error("the connection was closed")
+	}
+}
+
+func TestLongDataTooLarge(t *testing.T) {
+	mc := newMockConnData(mockResponse(1, []byte{iOK, 0, 0, 0, 0, 0, 0}))
+	mc.maxAllowedPacket = 1000
+	stmt := &mysqlStmt{mc: mc, id: 1, paramCount: 1}
+
+	// the size of a reader is only known after sending it
+	r := io.MultiReader(bytes.NewReader(make([]byte, 2000)))
+	if err := stmt.writeExecutePacket([]driver.Value{r}, cursorTypeNoCursor); !errors.Is(err, ErrPktTooLarge) {
+		t.Fatalf("got %v, want ErrPktTooLarge", err)
+	}
+	chunks, commands := longDataPackets(t, mc)
+	if len(chunks) != 1 {
+		t.Errorf("sent %d chunks, want 1", len(chunks))
+	}
+	if len(commands) != 1 || !bytes.Equal(commands[0], []byte{comStmtReset, 1, 0, 0, 0}) {
+		t.Errorf("commands = %x, want COM_STMT_RESET", commands)
+	}
+}
+
+func TestSkipColumns(t *testing.T) {
+	for _, deprecateEOF := range []bool{false, true} {
+		mc := newMockConnData()
+		if deprecateEOF {
+			mc.flags |= clientDeprecateEOF
+		}
+		pkts := [][]byte{mockColumn("a", fieldTypeLong, 0, 63), mockColumn("b", fieldTypeLong, 0, 63)}
+		if !deprecateEOF {
+			pkts = append(pkts, mockEOF(0))
+		}
+		pkts = append(pkts, mockTextRow("1", "2"))
+		mc.netConn.(*mockConn).reads = [][]byte{mockResponse(0, pkts...)}
+
+		if err := mc.skipColumns(2); err != nil {
+			t.Fatalf("deprecateEOF=%v: %v", deprecateEOF, err)
+		}
+		data, err := mc.readPacket()
+		if err != nil || !bytes.Equal(data, mockTextRow("1", "2")) {
+			t.Errorf("deprecateEOF=%v: next packet = %x, %v, want the first row", deprecateEOF, data, err)
+		}
+	}
+}
+
+func TestTextRowsDeprecateEOF(t *testing.T) {
+	// A value of 16 MiB has a length prefix of 0xfe and 8 bytes, so its row
+	// starts like an OK packet replacing EOF, but spans two packets.
+	long := bytes.Repeat([]byte{'x'}, 1<<24)
+	longRow := appendLengthEncodedString(nil, string(long))
+	if longRow[0] != iEOF {
+		t.Fatalf("long row starts with %x", longRow[0])
+	}
+
+	mc := newMockConnData(mockResponse(1,
+		[]byte{1},
+		mockColumn("v", fieldTypeLongBLOB, 0, 63),
+		// no EOF packet after the column definitions
+		mockTextRow("a"),
+		longRow[:maxPacketSize], longRow[maxPacketSize:],
+		mockOKEOF(statusInTrans),
+	))
+	mc.flags |= clientDeprecateEOF
+
+	rows, err := mc.query("SELECT v FROM t", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cols := rows.Columns(); len(cols) != 1 || cols[0] != "v" {
+		t.Fatalf("Columns() = %v, want [v]", cols)
+	}
+
+	dest := make([]driver.Value, 1)
+	if err := rows.Next(dest); err != nil || string(dest[0].([]byte)) != "a" {
+		t.Fatalf("first row = %q, %v, want \"a\"", dest[0], err)
+	}
+	if err := rows.Next(dest); err != nil {
+		t.Fatalf("long row: %v", err)
+	}
+	if !bytes.Equal(dest[0].([]byte), long) {
+		t.Errorf("long row has %d bytes, want %d", len(dest[0].([]byte)), len(long))
+	}
+	if err := rows.Next(dest); err != io.EOF {
+		t.Fatalf("got %v, want io.EOF", err)
+	}
+	// the status is taken from the OK packet
+	if mc.status != statusInTrans {
+		t.Errorf("status = %#x, want %#x", mc.status, statusInTrans)
+	}
+	if err := rows.Close(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestBinaryRowsSmallIntegers(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	row := []byte{iOK, 0x00, 0xff, 0xff, 0xfe, 0xff, 0xfe, 0xff}
+	go func() {
+		server.Write(append([]byte{byte(len(row)), 0, 0, 0}, row...))
+		server.Close()
+	}()
+
+	rows := new(binaryRows)
+	rows.mc = &mysqlConn{buf: newBuffer(), netConn: client, cfg: NewConfig()}
+	rows.rs.columns = []mysqlField{
+		{name: "tiny", fieldType: fieldTypeTiny},
+		{name: "utiny", fieldType: fieldTypeTiny, flags: flagUnsigned},
+		{name: "short", fieldType: fieldTypeShort},
+		{name: "ushort", fieldType: fieldTypeShort, flags: flagUnsigned},
+	}
+
+	// negative values must be sign extended, not wrapped into uint64
+	dest := make([]driver.Value, 4)
+	if err := rows.readRow(dest); err != nil {
+		t.Fatal(err)
+	}
+	want := []driver.Value{int64(-1), int64(255), int64(-2), int64(65534)}
+	for i := range want {
+		if dest[i] != want[i] {
+			t.Errorf("%s = %#v, want %#v", rows.rs.columns[i].name, dest[i], want[i])
+		}
+	}
+}
+
+func TestReadResultSetHeaderError(t *testing.T) {
+	client, server := net.Pipe()
+	defer client.Close()
+	errPkt := append([]byte{iERR, 0x7a, 0x04, '#', '4', '2', 'S', '0', '2'}, "Table 'db.t' doesn't exist"...)
+	go func() {
+		// read the query, then answer it with an ERR packet
+		server.Read(make([]byte, 1024))
+		server.Write(append([]byte{byte(len(errPkt)), 0, 0, 1}, errPkt...))
+		server.Close()
+	}()
+	mc := &mysqlConn{buf: newBuffer(), netConn: client, cfg: NewConfig(), maxAllowedPacket: maxPacketSize}
+
+	// an ERR packet must not be read as the column count of a result set
+	done := make(chan error, 1)
+	go func() {
+		_, err := mc.query("SELECT * FROM t", nil)
+		done <- err
+	}()
+	var err error
+	select {
+	case err = <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the query is still waiting for column definitions")
+	}
+	var me *MySQLError
+	if !errors.As(err, &me) || me.Number != 1146 {
+		t.Fatalf("got %v, want error 1146", err)
+	}
+	if mc.closed.Load() {
+		t.Error("the connection was closed")
+	}
+}
@@ -1,14 +1,78 @@
 package mysqldriver
 
+import "database/sql/driver"
+
+// Result exposes data not available through sql.Result or driver.Result.
+//
+// This is accessible by executing statements using sql.Conn.Raw() and
+// downcasting the returned result:
+//
+//	res, err := rawConn.Exec(...)
+//	res.(mysqldriver.Result).AllRowsAffected()
+type Result interface {
+	driver.Result
+	// AllRowsAffected returns a slice containing the affected rows for each
+	// executed statement.
+	AllRowsAffected() []int64
+	// AllLastInsertIds returns a slice containing the last inserted ID for each
+	// executed statement.
+	AllLastInsertIds() []int64
+	// WarningCount returns the number of warnings of the last executed statement.
+	WarningCount() uint16
+	// Warnings returns the warnings of the last executed statement if they
+	// were fetched, see MySQLWarnings.
+	Warnings() MySQLWarnings
+	// Info returns the human-readable status of the last executed statement,
+	// e.g. "Rows matched: 3  Changed: 1  Warnings: 0".
+	Info() string
+}
+
 type mysqlResult struct {
-	affectedRows []int64
-	insertIds    []int64
+	// One entry in each slice is created for every executed statement result.
+	affectedRows  []int64
+	insertIds     []int64
+	warningCounts []uint16
+	infos         []string
+
+	warnings MySQLWarnings
 }
 
 func (res *mysqlResult) LastInsertId() (int64, error) {
+	if len(res.insertIds) == 0 {
+		return 0, nil
+	}
 	return res.insertIds[len(res.insertIds)-1], nil
 }
 
 func (res *mysqlResult) RowsAffected() (int64, error) {
+	if len(res.affectedRows) == 0 {
+		return 0, nil
+	}
 	return res.affectedRows[len(res.affectedRows)-1], nil
 }
+
+func (res *mysqlResult) AllLastInsertIds() []int64 {
+	return append([]int64{}, res.insertIds...) // defensive copy
+}
+
+func (res *mysqlResult) AllRowsAffected() []int64 {
+	return append([]int64{}, res.affectedRows...) // defensive copy
+}
+
+func (res *mysqlResult) WarningCount() uint16 {
+	if len(res.warningCounts) == 0 {
+		return 0
+	}
+	return res.warningCounts[len(res.warningCounts)-1]
+}
+
+func (res *mysqlResult) Warnings() MySQLWarnings {
+	return res.warnings
+}
+
+func (res *mysqlResult) Info() string {
+	if len(res.infos) == 0 {
+		return ""
+	}
+	return res.infos[len(res.infos)-1]
+}
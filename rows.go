@@ -3,21 +3,33 @@ package mysqldriver
 import (
 	"database/sql/driver"
 	"io"
+	"math"
+	"reflect"
 )
 
 type resultSet struct {
 	columns     []mysqlField
 	columnNames []string
 	done        bool
+	warnings    uint16
 }
 
 type mysqlRows struct {
-	mc     *mysqlConn
-	rs     resultSet
-	finish func()
+	mc            *mysqlConn
+	rs            resultSet
+	finish        func()
+	fetchWarnings bool // report warnings after the last result set
 }
 type binaryRows struct {
 	mysqlRows
+
+	// Rows of a server-side cursor are requested in batches of fetchSize
+	// with COM_STMT_FETCH. fetchSize is 0 if no cursor is open.
+	stmtID      uint32
+	fetchSize   uint32
+	fetching    bool   // a COM_STMT_FETCH response is being read
+	lastRowSent bool   // the last batch of the cursor was read
+	pending     []byte // row read ahead while checking for a cursor
 }
 type textRows struct {
 	mysqlRows
@@ -36,8 +48,116 @@ func (rows *mysqlRows) Columns() []string {
 	rows.rs.columnNames = columns
 	return columns
 }
-func (rows *mysqlRows) Close() error {
-	return nil // TODO: implement close
+
+// ColumnSource describes where a result column was read from.
+// The table and schema fields are empty for computed columns.
+type ColumnSource struct {
+	Schema   string // database of the table
+	Table    string // table name or alias as written in the query
+	OrgTable string // physical table name
+	Name     string // column name or alias as written in the query
+	OrgName  string // physical column name
+}
+
+// RowsColumnTypeSource may be implemented by driver.Rows returned from this
+// driver (e.g. via sql.Conn.Raw). It returns the origin of the column at index.
+type RowsColumnTypeSource interface {
+	driver.Rows
+	ColumnTypeSource(index int) ColumnSource
+}
+
+func (rows *mysqlRows) ColumnTypeSource(i int) ColumnSource {
+	column := rows.rs.columns[i]
+	return ColumnSource{
+		Schema:   column.schema,
+		Table:    column.tableName,
+		OrgTable: column.orgTable,
+		Name:     column.name,
+		OrgName:  column.orgName,
+	}
+}
+
+func (rows *mysqlRows) ColumnTypeDatabaseTypeName(i int) string {
+	return rows.rs.columns[i].typeDatabaseName()
+}
+
+func (rows *mysqlRows) ColumnTypeLength(i int) (length int64, ok bool) {
+	column := rows.rs.columns[i]
+	switch column.fieldType {
+	case fieldTypeVarChar, fieldTypeVarString, fieldTypeString,
+		fieldTypeTinyBLOB, fieldTypeMediumBLOB, fieldTypeLongBLOB,
+		fieldTypeBLOB, fieldTypeJSON, fieldTypeGeometry, fieldTypeVector:
+		// the length is reported in bytes, not characters
+		return int64(column.length), true
+	}
+	return 0, false
+}
+
+func (rows *mysqlRows) ColumnTypeNullable(i int) (nullable, ok bool) {
+	return rows.rs.columns[i].flags&flagNotNULL == 0, true
+}
+
+func (rows *mysqlRows) ColumnTypePrecisionScale(i int) (int64, int64, bool) {
+	column := rows.rs.columns[i]
+	decimals := int64(column.decimals)
+
+	switch column.fieldType {
+	case fieldTypeDecimal, fieldTypeNewDecimal:
+		// the length includes the sign and the decimal point
+		precision := int64(column.length)
+		if column.flags&flagUnsigned == 0 {
+			precision--
+		}
+		if decimals > 0 {
+			precision--
+		}
+		return precision, decimals, true
+	case fieldTypeTimestamp, fieldTypeDateTime, fieldTypeTime:
+		return decimals, decimals, true
+	case fieldTypeFloat, fieldTypeDouble:
+		if decimals == 0x1f {
+			return math.MaxInt64, math.MaxInt64, true
+		}
+		return math.MaxInt64, decimals, true
+	}
+
+	return 0, 0, false
+}
+
+func (rows *mysqlRows) ColumnTypeScanType(i int) reflect.Type {
+	return rows.rs.columns[i].scanType()
+}
+
+func (rows *mysqlRows) Close() (err error) {
+	// release the context watcher exactly once, even if Close is called again
+	if f := rows.finish; f != nil {
+		f()
+		rows.finish = nil
+	}
+
+	mc := rows.mc
+	if mc == nil {
+		return nil
+	}
+	rows.mc = nil
+
+	// the connection was canceled or closed while the rows were open;
+	// there is nothing left to drain.
+	if err := mc.error(); err != nil {
+		return err
+	}
+
+	// Remove unread packets from stream
+	if !rows.rs.done {
+		err = mc.readUntilEOF()
+		rows.rs.done = true
+	}
+	if err == nil {
+		// the OK packets of the remaining results still belong to this
+		// command and may change its session state
+		err = mc.resultUnchanged().discardResults()
+	}
+	return err
 }
 
 func (rows *mysqlRows) HasNextResultSet() (b bool) {
@@ -89,6 +209,82 @@ func (rows *mysqlRows) nextNotEmptyResultSet() (int, error) {
 	}
 }
 
+// openCursor checks whether the server opened a cursor for the result set
+// whose column definitions were just read.
+func (rows *binaryRows) openCursor(stmtID uint32, fetchSize int) error {
+	mc := rows.mc
+	if mc.flags&clientDeprecateEOF != 0 {
+		// The column definitions of a cursor are followed by an OK packet
+		// with statusCursorExists. Otherwise the rows follow directly.
+		data, err := mc.readPacket()
+		if err != nil {
+			return err
+		}
+		switch {
+		case data[0] == iERR:
+			return mc.handleErrorPacket(data)
+		case !mc.isEOFPacket(data):
+			rows.pending = append([]byte(nil), data...)
+			return nil
+		}
+		if err := mc.handleEOFPacket(data); err != nil {
+			return err
+		}
+		if mc.status&statusCursorExists == 0 {
+			// empty result set without a cursor
+			rows.rs.done = true
+			if !rows.HasNextResultSet() {
+				rows.mc = nil
+			}
+			return nil
+		}
+	}
+
+	if mc.status&statusCursorExists != 0 {
+		rows.stmtID = stmtID
+		rows.fetchSize = uint32(fetchSize)
+	}
+	return nil
+}
+
+// closeCursor finishes reading the current batch, then closes the cursor on
+// the server unless it was exhausted. Unlike rows of a result set, unread
+// rows of a cursor are not sent by the server, so they can't be drained.
+func (rows *binaryRows) closeCursor() error {
+	mc := rows.mc
+	var err error
+	if rows.fetching {
+		err = mc.readUntilEOF()
+		rows.endBatch()
+	}
+	if err == nil && !rows.lastRowSent {
+		err = mc.resetStmt(rows.stmtID)
+	}
+	rows.fetchSize = 0
+	rows.rs.done = true
+	return err
+}
+
+// endBatch records whether the batch whose EOF packet was just read was the
+// last one. The status flag is kept with the rows, as mc.status is replaced
+// by the next packet read from the connection.
+func (rows *binaryRows) endBatch() {
+	rows.fetching = false
+	rows.lastRowSent = rows.mc.status&statusLastRowSent != 0
+}
+
+func (rows *binaryRows) Close() error {
+	if mc := rows.mc; mc != nil && rows.fetchSize > 0 && mc.error() == nil {
+		if err := rows.closeCursor(); err != nil {
+			// only release the watcher, the stream can't be recovered
+			rows.mc = nil
+			rows.mysqlRows.Close()
+			return err
+		}
+	}
+	return rows.mysqlRows.Close()
+}
+
 func (rows *binaryRows) Next(dest []driver.Value) error {
 	if mc := rows.mc; mc != nil {
 		if err := mc.error(); err != nil {
@@ -99,6 +295,14 @@ func (rows *binaryRows) Next(dest []driver.Value) error {
 	return io.EOF
 }
 func (rows *binaryRows) NextResultSet() error {
+	// a cursor is only opened for a single result set
+	if mc := rows.mc; mc != nil && rows.fetchSize > 0 && mc.error() == nil {
+		if err := rows.closeCursor(); err != nil {
+			rows.mc = nil
+			return err
+		}
+	}
+
 	resLen, err := rows.nextNotEmptyResultSet()
 	if err != nil {
 		return err
@@ -117,3 +321,12 @@ func (rows *textRows) Next(dest []driver.Value) error {
 	}
 	return io.EOF
 }
+func (rows *textRows) NextResultSet() (err error) {
+	resLen, err := rows.nextNotEmptyResultSet()
+	if err != nil {
+		return err
+	}
+
+	rows.rs.columns, err = rows.mc.readColumns(resLen)
+	return err
+}
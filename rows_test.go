@@ -0,0 +1,258 @@
+package mysqldriver
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/binary"
+	"io"
+	"math"
+	"testing"
+)
+
+// mockIntRow returns a binary protocol row with a single INT value.
+func mockIntRow(v int32) []byte {
+	return binary.LittleEndian.AppendUint32([]byte{iOK, 0x00}, uint32(v))
+}
+
+// mockEnd returns the packet terminating columns or rows on mc.
+func mockEnd(mc *mysqlConn, status statusFlag) []byte {
+	if mc.flags&clientDeprecateEOF != 0 {
+		return mockOKEOF(status)
+	}
+	return mockEOF(status)
+}
+
+// mockCursorResponse is the response to COM_STMT_EXECUTE opening a cursor
+// over a single INT column.
+func mockCursorResponse(mc *mysqlConn) []byte {
+	return mockResponse(1, []byte{1}, mockColumn("id", fieldTypeLong, 0, 63), mockEnd(mc, statusCursorExists))
+}
+
+func wantCommands(t *testing.T, mc *mysqlConn, want ...[]byte) {
+	t.Helper()
+	got := mc.netConn.(*mockConn).packets()
+	if len(got) != len(want) {
+		t.Fatalf("sent %d commands, want %d: %x", len(got), len(want), got)
+	}
+	for i := range want {
+		if !bytes.Equal(got[i], want[i]) {
+			t.Errorf("command %d = %x, want %x", i, got[i], want[i])
+		}
+	}
+}
+
+var (
+	testExecuteCursor = []byte{comStmtExecute, 1, 0, 0, 0, cursorTypeReadOnly, 1, 0, 0, 0}
+	testFetch2        = []byte{comStmtFetch, 1, 0, 0, 0, 2, 0, 0, 0}
+	testReset         = []byte{comStmtReset, 1, 0, 0, 0}
+)
+
+func TestCursorFetchBatches(t *testing.T) {
+	for _, deprecateEOF := range []bool{false, true} {
+		mc := newMockConnData()
+		if deprecateEOF {
+			mc.flags |= clientDeprecateEOF
+		}
+		mc.netConn.(*mockConn).reads = [][]byte{
+			mockCursorResponse(mc),
+			mockResponse(1, mockIntRow(1), mockIntRow(-2), mockEnd(mc, statusCursorExists)),
+			// the last batch ends with SERVER_STATUS_LAST_ROW_SENT
+			mockResponse(1, mockIntRow(3), mockEnd(mc, statusCursorExists|statusLastRowSent)),
+		}
+
+		stmt := &mysqlStmt{mc: mc, id: 1}
+		rows, err := stmt.query(nil, 2)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rows.fetchSize != 2 {
+			t.Fatalf("deprecateEOF=%v: no cursor opened", deprecateEOF)
+		}
+
+		var got []int64
+		dest := make([]driver.Value, 1)
+		for {
+			err := rows.Next(dest)
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				t.Fatalf("deprecateEOF=%v: %v", deprecateEOF, err)
+			}
+			got = append(got, dest[0].(int64))
+		}
+		if len(got) != 3 || got[0] != 1 || got[1] != -2 || got[2] != 3 {
+			t.Errorf("deprecateEOF=%v: got rows %v, want [1 -2 3]", deprecateEOF, got)
+		}
+		// the server closed the exhausted cursor itself
+		if err := rows.Close(); err != nil {
+			t.Fatal(err)
+		}
+		wantCommands(t, mc, testExecuteCursor, testFetch2, testFetch2)
+	}
+}
+
+func TestCursorCloseEarly(t *testing.T) {
+	mc := newMockConnData()
+	mc.netConn.(*mockConn).reads = [][]byte{
+		mockCursorResponse(mc),
+		mockResponse(1, mockIntRow(1), mockIntRow(2), mockEOF(statusCursorExists)),
+		mockResponse(1, []byte{iOK, 0, 0, 0, 0, 0, 0}), // COM_STMT_RESET
+	}
+
+	stmt := &mysqlStmt{mc: mc, id: 1}
+	rows, err := stmt.query(nil, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dest := make([]driver.Value, 1)
+	if err := rows.Next(dest); err != nil {
+		t.Fatal(err)
+	}
+	// the rest of the batch is read before the cursor is closed
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+	wantCommands(t, mc, testExecuteCursor, testFetch2, testReset)
+}
+
+func TestCursorCloseLastBatch(t *testing.T) {
+	mc := newMockConnData()
+	mc.netConn.(*mockConn).reads = [][]byte{
+		mockCursorResponse(mc),
+		mockResponse(1, mockIntRow(1), mockIntRow(2), mockEOF(statusCursorExists|statusLastRowSent)),
+	}
+
+	stmt := &mysqlStmt{mc: mc, id: 1}
+	rows, err := stmt.query(nil, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dest := make([]driver.Value, 1)
+	if err := rows.Next(dest); err != nil {
+		t.Fatal(err)
+	}
+	// the batch read by Close was the last one, the cursor is closed already
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+	wantCommands(t, mc, testExecuteCursor, testFetch2)
+}
+
+func TestCursorNextResultSet(t *testing.T) {
+	mc := newMockConnData()
+	mc.netConn.(*mockConn).reads = [][]byte{
+		mockCursorResponse(mc),
+		mockResponse(1, []byte{iOK, 0, 0, 0, 0, 0, 0}), // COM_STMT_RESET
+	}
+
+	stmt := &mysqlStmt{mc: mc, id: 1}
+	rows, err := stmt.query(nil, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// no COM_STMT_FETCH is in flight, the server waits for a command
+	if err := rows.NextResultSet(); err != io.EOF {
+		t.Fatalf("got %v, want io.EOF", err)
+	}
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+	wantCommands(t, mc, testExecuteCursor, testReset)
+}
+
+func TestCursorNotOpened(t *testing.T) {
+	// with CLIENT_DEPRECATE_EOF, the rows follow the columns directly if the
+	// server didn't open a cursor, e.g. for a statement that isn't a SELECT
+	mc := newMockConnData()
+	mc.flags |= clientDeprecateEOF
+	mc.netConn.(*mockConn).reads = [][]byte{mockResponse(1,
+		[]byte{1}, mockColumn("id", fieldTypeLong, 0, 63),
+		mockIntRow(7), mockOKEOF(0))}
+
+	stmt := &mysqlStmt{mc: mc, id: 1}
+	rows, err := stmt.query(nil, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rows.fetchSize != 0 {
+		t.Fatal("cursor opened")
+	}
+	dest := make([]driver.Value, 1)
+	if err := rows.Next(dest); err != nil || dest[0] != int64(7) {
+		t.Fatalf("got %v, %v, want 7", dest[0], err)
+	}
+	if err := rows.Next(dest); err != io.EOF {
+		t.Fatalf("got %v, want io.EOF", err)
+	}
+	wantCommands(t, mc, testExecuteCursor)
+}
+
+func TestRowsClosePendingResultSets(t *testing.T) {
+	more := statusMoreResultsExists
+	mc := newMockConnData(
+		mockResponse(1,
+			[]byte{1}, mockColumn("a", fieldTypeLong, 0, 63), mockEOF(more),
+			mockTextRow("1"), mockTextRow("2"), mockEOF(more),
+			// UPDATE without a result set
+			[]byte{iOK, 3, 0, byte(more), 0, 0, 0},
+			[]byte{1}, mockColumn("b", fieldTypeLong, 0, 63), mockEOF(more),
+			mockTextRow("3"), mockEOF(0),
+		),
+		mockResponse(1, []byte{iOK, 5, 0, 0, 0, 0, 0}),
+	)
+
+	rows, err := mc.query("SELECT a FROM t; UPDATE t SET a = 0; SELECT b FROM t", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dest := make([]driver.Value, 1)
+	if err := rows.Next(dest); err != nil {
+		t.Fatal(err)
+	}
+	// the rest of the first result set and the pending ones are drained
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if mc.status&statusMoreResultsExists != 0 {
+		t.Error("more results pending after Close")
+	}
+
+	res, err := mc.Exec("DELETE FROM t", nil)
+	if err != nil {
+		t.Fatalf("next command: %v", err)
+	}
+	if n, _ := res.RowsAffected(); n != 5 {
+		t.Errorf("RowsAffected() = %d, want 5", n)
+	}
+	wantCommands(t, mc,
+		append([]byte{comQuery}, "SELECT a FROM t; UPDATE t SET a = 0; SELECT b FROM t"...),
+		append([]byte{comQuery}, "DELETE FROM t"...))
+}
+
+func TestColumnTypePrecisionScale(t *testing.T) {
+	tests := []struct {
+		field            mysqlField
+		precision, scale int64
+		ok               bool
+	}{
+		// DECIMAL(10,2): the length includes the sign and the decimal point
+		{mysqlField{fieldType: fieldTypeNewDecimal, length: 12, decimals: 2}, 10, 2, true},
+		{mysqlField{fieldType: fieldTypeNewDecimal, length: 11, decimals: 2, flags: flagUnsigned}, 10, 2, true},
+		{mysqlField{fieldType: fieldTypeNewDecimal, length: 6}, 5, 0, true},
+		{mysqlField{fieldType: fieldTypeDateTime, length: 23, decimals: 3}, 3, 3, true},
+		{mysqlField{fieldType: fieldTypeTime, length: 10}, 0, 0, true},
+		{mysqlField{fieldType: fieldTypeDouble, length: 22, decimals: 0x1f}, math.MaxInt64, math.MaxInt64, true},
+		{mysqlField{fieldType: fieldTypeFloat, length: 10, decimals: 4}, math.MaxInt64, 4, true},
+		{mysqlField{fieldType: fieldTypeLong, length: 11}, 0, 0, false},
+	}
+	for _, tt := range tests {
+		rows := &mysqlRows{rs: resultSet{columns: []mysqlField{tt.field}}}
+		precision, scale, ok := rows.ColumnTypePrecisionScale(0)
+		if precision != tt.precision || scale != tt.scale || ok != tt.ok {
+			t.Errorf("type %d, length %d, decimals %d: got %d, %d, %v, want %d, %d, %v",
+				tt.field.fieldType, tt.field.length, tt.field.decimals,
+				precision, scale, ok, tt.precision, tt.scale, tt.ok)
+		}
+	}
+}
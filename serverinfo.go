@@ -0,0 +1,101 @@
+package mysqldriver
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ServerFlavor is the server implementation a connection is connected to.
+type ServerFlavor string
+
+const (
+	FlavorMySQL   ServerFlavor = "MySQL"
+	FlavorMariaDB ServerFlavor = "MariaDB"
+	FlavorPercona ServerFlavor = "Percona"
+	FlavorTiDB    ServerFlavor = "TiDB"
+)
+
+// ServerInfo describes the server as announced in its handshake.
+type ServerInfo struct {
+	// Version is the version string of the server, e.g. "8.0.36",
+	// "11.4.2-MariaDB-ubu2404" or "8.0.11-TiDB-v7.5.0".
+	Version string
+	// Major, Minor and Patch are the parsed version number. MariaDB's
+	// "5.5.5-" prefix is skipped, TiDB reports the MySQL version it is
+	// compatible with.
+	Major, Minor, Patch int
+	// Flavor is guessed from Version. Percona Server is recognized by its
+	// build number suffix, e.g. "8.0.36-28".
+	Flavor ServerFlavor
+	// ThreadID is the connection ID, as used by KILL and returned by
+	// CONNECTION_ID().
+	ThreadID uint32
+	// Capabilities are the capability flags the server supports and
+	// ClientCapabilities the subset the connection uses.
+	// https://dev.mysql.com/doc/dev/mysql-server/latest/group__group__cs__capabilities__flags.html
+	Capabilities       uint32
+	ClientCapabilities uint32
+	// CollationID is the default collation of the server.
+	CollationID uint8
+	// Status are the server status flags sent in the handshake.
+	Status uint16
+}
+
+// AtLeast reports whether the server version is major.minor.patch or newer.
+func (si ServerInfo) AtLeast(major, minor, patch int) bool {
+	if si.Major != major {
+		return si.Major > major
+	}
+	if si.Minor != minor {
+		return si.Minor > minor
+	}
+	return si.Patch >= patch
+}
+
+// ServerInfoProvider is implemented by connections of this driver. Use
+// sql.Conn.Raw to access it:
+//
+//	conn.Raw(func(driverConn any) error {
+//		info := driverConn.(mysqldriver.ServerInfoProvider).ServerInfo()
+//		...
+//	})
+type ServerInfoProvider interface {
+	ServerInfo() ServerInfo
+}
+
+func (mc *mysqlConn) ServerInfo() ServerInfo {
+	info := mc.server
+	info.ClientCapabilities = uint32(mc.flags)
+	return info
+}
+
+var (
+	serverVersionRE = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)`)
+	// Percona Server appends its build number, e.g. "5.7.44-48" or "8.0.35-27.1"
+	perconaVersionRE = regexp.MustCompile(`^\d+\.\d+\.\d+-\d+(\.\d+)?$`)
+)
+
+// parseServerVersion sets Version, Major, Minor, Patch and Flavor of si.
+func (si *ServerInfo) parseServerVersion(version string) {
+	si.Version = version
+
+	switch {
+	case strings.Contains(version, "MariaDB"):
+		si.Flavor = FlavorMariaDB
+		// MariaDB 10 prefixes its version for old replication clients
+		version = strings.TrimPrefix(version, "5.5.5-")
+	case strings.Contains(version, "TiDB"):
+		si.Flavor = FlavorTiDB
+	case strings.Contains(version, "Percona") || perconaVersionRE.MatchString(version):
+		si.Flavor = FlavorPercona
+	default:
+		si.Flavor = FlavorMySQL
+	}
+
+	if m := serverVersionRE.FindStringSubmatch(version); m != nil {
+		si.Major, _ = strconv.Atoi(m[1])
+		si.Minor, _ = strconv.Atoi(m[2])
+		si.Patch, _ = strconv.Atoi(m[3])
+	}
+}
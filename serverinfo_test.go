@@ -0,0 +1,36 @@
+package mysqldriver
+
+import "testing"
+
+func TestParseServerVersion(t *testing.T) {
+	tests := []struct {
+		version             string
+		flavor              ServerFlavor
+		major, minor, patch int
+	}{
+		{"8.0.36", FlavorMySQL, 8, 0, 36},
+		{"8.0.36-0ubuntu0.22.04.1", FlavorMySQL, 8, 0, 36},
+		{"5.7.44-log", FlavorMySQL, 5, 7, 44},
+		{"9.1.0-commercial", FlavorMySQL, 9, 1, 0},
+		{"5.5.5-10.11.6-MariaDB-1:10.11.6+maria~ubu2204", FlavorMariaDB, 10, 11, 6},
+		{"11.4.2-MariaDB-ubu2404", FlavorMariaDB, 11, 4, 2},
+		{"8.0.35-27", FlavorPercona, 8, 0, 35},
+		{"5.7.44-48", FlavorPercona, 5, 7, 44},
+		{"8.0.35-27.1", FlavorPercona, 8, 0, 35},
+		{"8.0.11-TiDB-v7.5.0", FlavorTiDB, 8, 0, 11},
+		{"5.7.25-TiDB-v6.5.3", FlavorTiDB, 5, 7, 25},
+	}
+	for _, tst := range tests {
+		var si ServerInfo
+		si.parseServerVersion(tst.version)
+		if si.Version != tst.version || si.Flavor != tst.flavor || si.Major != tst.major || si.Minor != tst.minor || si.Patch != tst.patch {
+			t.Errorf("%q: got %s %d.%d.%d, want %s %d.%d.%d", tst.version, si.Flavor, si.Major, si.Minor, si.Patch,
+				tst.flavor, tst.major, tst.minor, tst.patch)
+		}
+	}
+
+	si := ServerInfo{Major: 8, Minor: 0, Patch: 22}
+	if !si.AtLeast(8, 0, 22) || !si.AtLeast(5, 7, 40) || si.AtLeast(8, 0, 23) || si.AtLeast(8, 4, 0) {
+		t.Errorf("AtLeast is wrong for %d.%d.%d", si.Major, si.Minor, si.Patch)
+	}
+}
@@ -0,0 +1,127 @@
+package mysqldriver
+
+import (
+	"maps"
+)
+
+// https://dev.mysql.com/doc/dev/mysql-server/latest/mysql__com_8h.html#a1d854e841086925be1883e4d7b4e8cad
+const (
+	sessionTrackSystemVariables byte = iota
+	sessionTrackSchema
+	sessionTrackStateChange
+	sessionTrackGtids
+	sessionTrackTransactionCharacteristics
+	sessionTrackTransactionState
+)
+
+// SessionState holds the session state changes the server reported in the
+// OK packets of the last command. Which changes are reported is controlled by
+// the session_track_* system variables of the server, e.g. GTIDs are only
+// sent with session_track_gtids=OWN_GTID.
+type SessionState struct {
+	// Schema is the new default schema, if it was changed.
+	Schema string
+	// SystemVariables maps the names of changed system variables to their
+	// new values.
+	SystemVariables map[string]string
+	// GTIDs is the GTID set reported after a transaction was committed.
+	GTIDs string
+	// StateChanged reports whether the session state was changed in a way
+	// that matters for session migration (session_track_state_change).
+	StateChanged bool
+	// TransactionCharacteristics holds the SQL statements that restore the
+	// characteristics of the current transaction, e.g. "START TRANSACTION READ ONLY;".
+	TransactionCharacteristics string
+	// TransactionState is the 8 character transaction state string
+	// (session_track_transaction_info).
+	TransactionState string
+}
+
+// SessionStateTracker is implemented by connections of this driver. Use
+// sql.Conn.Raw to access it:
+//
+//	conn.Raw(func(driverConn any) error {
+//		gtids := driverConn.(mysqldriver.SessionStateTracker).SessionState().GTIDs
+//		...
+//	})
+type SessionStateTracker interface {
+	// SessionState returns the session state changes reported by the server
+	// for the last command executed on the connection.
+	SessionState() SessionState
+}
+
+func (mc *mysqlConn) SessionState() SessionState {
+	state := mc.session
+	state.SystemVariables = maps.Clone(mc.session.SystemVariables)
+	return state
+}
+
+// parse merges the session state information of an OK packet into state.
+// https://dev.mysql.com/doc/dev/mysql-server/latest/page_protocol_basic_ok_packet.html
+func (state *SessionState) parse(data []byte) error {
+	for pos := 0; pos < len(data); {
+		// type [1 byte]
+		typ := data[pos]
+		pos++
+
+		// data [len coded string]
+		entry, _, n, err := readLengthEncodedString(data[pos:])
+		if err != nil {
+			return err
+		}
+		pos += n
+
+		switch typ {
+		case sessionTrackSystemVariables:
+			name, _, n, err := readLengthEncodedString(entry)
+			if err != nil {
+				return err
+			}
+			value, _, _, err := readLengthEncodedString(entry[n:])
+			if err != nil {
+				return err
+			}
+			if state.SystemVariables == nil {
+				state.SystemVariables = make(map[string]string)
+			}
+			state.SystemVariables[string(name)] = string(value)
+		case sessionTrackSchema:
+			schema, _, _, err := readLengthEncodedString(entry)
+			if err != nil {
+				return err
+			}
+			state.Schema = string(schema)
+		case sessionTrackStateChange:
+			changed, _, _, err := readLengthEncodedString(entry)
+			if err != nil {
+				return err
+			}
+			state.StateChanged = string(changed) == "1"
+		case sessionTrackGtids:
+			// encoding specification [1 byte], only 0 is defined
+			if len(entry) == 0 {
+				return ErrMalformPkt
+			}
+			gtids, _, _, err := readLengthEncodedString(entry[1:])
+			if err != nil {
+				return err
+			}
+			state.GTIDs = string(gtids)
+		case sessionTrackTransactionCharacteristics:
+			characteristics, _, _, err := readLengthEncodedString(entry)
+			if err != nil {
+				return err
+			}
+			state.TransactionCharacteristics = string(characteristics)
+		case sessionTrackTransactionState:
+			trxState, _, _, err := readLengthEncodedString(entry)
+			if err != nil {
+				return err
+			}
+			state.TransactionState = string(trxState)
+		default:
+			// unknown types are skipped to stay compatible with newer servers
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package mysqldriver
+
+import (
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// trackerEntry returns a session state change of type typ holding data.
+func trackerEntry(typ byte, data ...string) []byte {
+	var entry []byte
+	for _, s := range data {
+		entry = appendLengthEncodedString(entry, s)
+	}
+	return appendLengthEncodedString([]byte{typ}, string(entry))
+}
+
+func TestSessionStateParse(t *testing.T) {
+	join := func(entries ...[]byte) (b []byte) {
+		for _, e := range entries {
+			b = append(b, e...)
+		}
+		return b
+	}
+	tests := []struct {
+		name    string
+		data    []byte
+		want    SessionState
+		wantErr bool
+	}{
+		{name: "empty", data: nil},
+		{
+			name: "system variables",
+			data: join(
+				trackerEntry(sessionTrackSystemVariables, "autocommit", "OFF"),
+				trackerEntry(sessionTrackSystemVariables, "time_zone", ""),
+			),
+			want: SessionState{SystemVariables: map[string]string{"autocommit": "OFF", "time_zone": ""}},
+		},
+		{
+			name: "schema",
+			data: trackerEntry(sessionTrackSchema, "db"),
+			want: SessionState{Schema: "db"},
+		},
+		{
+			name: "state change",
+			data: trackerEntry(sessionTrackStateChange, "1"),
+			want: SessionState{StateChanged: true},
+		},
+		{
+			name: "GTIDs",
+			data: appendLengthEncodedString([]byte{sessionTrackGtids}, "\x00"+string(appendLengthEncodedString(nil, "3e11fa47-71ca-11e1-9e33-c80aa9429562:23"))),
+			want: SessionState{GTIDs: "3e11fa47-71ca-11e1-9e33-c80aa9429562:23"},
+		},
+		{
+			name: "transaction characteristics",
+			data: trackerEntry(sessionTrackTransactionCharacteristics, "START TRANSACTION READ ONLY;"),
+			want: SessionState{TransactionCharacteristics: "START TRANSACTION READ ONLY;"},
+		},
+		{
+			name: "transaction state",
+			data: trackerEntry(sessionTrackTransactionState, "T_______"),
+			want: SessionState{TransactionState: "T_______"},
+		},
+		{
+			name: "unknown type",
+			data: join(trackerEntry(0x7f, "future"), trackerEntry(sessionTrackSchema, "db")),
+			want: SessionState{Schema: "db"},
+		},
+		{name: "type without data", data: []byte{sessionTrackSchema}, wantErr: true},
+		{name: "truncated data", data: trackerEntry(sessionTrackSchema, "db")[:3], wantErr: true},
+		{name: "truncated length", data: []byte{sessionTrackSchema, 0xfc, 0x01}, wantErr: true},
+		{name: "variable without value", data: trackerEntry(sessionTrackSystemVariables, "autocommit"), wantErr: true},
+		{name: "empty GTIDs", data: []byte{sessionTrackGtids, 0}, wantErr: true},
+		{name: "GTIDs without set", data: []byte{sessionTrackGtids, 1, 0}, wantErr: true},
+		{name: "truncated unknown type", data: []byte{0x7f, 5, 'a'}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var state SessionState
+			err := state.parse(tt.data)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error: %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(state, tt.want) {
+				t.Errorf("got %+v, want %+v", state, tt.want)
+			}
+		})
+	}
+}
+
+func TestHandleOkPacketTruncated(t *testing.T) {
+	// 257 affected rows, no insert ID, empty info and the new schema
+	okPkt := []byte{iOK, 0xfc, 0x01, 0x01, 0, 0x00, 0x40, 0, 0} // status: SERVER_SESSION_STATE_CHANGED
+	okPkt = appendLengthEncodedString(okPkt, "")
+	okPkt = appendLengthEncodedString(okPkt, string(trackerEntry(sessionTrackSchema, "db")))
+
+	mc := newMockConn(nil)
+	mc.flags = clientProtocol41 | clientSessionTrack
+	if err := mc.clearResult().handleOkPacket(okPkt); err != nil {
+		t.Fatal(err)
+	}
+	if mc.session.Schema != "db" {
+		t.Errorf("Schema = %q, want %q", mc.session.Schema, "db")
+	}
+
+	// the info field may be omitted, but no other field may be cut off
+	for n := 1; n < len(okPkt); n++ {
+		err := mc.clearResult().handleOkPacket(okPkt[:n])
+		if n == 9 {
+			if err != nil {
+				t.Errorf("%d bytes: %v", n, err)
+			}
+			continue
+		}
+		if !errors.Is(err, ErrMalformPkt) {
+			t.Errorf("%d bytes: got %v, want ErrMalformPkt", n, err)
+		}
+	}
+}
+
+func TestRowsCloseKeepsSessionState(t *testing.T) {
+	okEOF := []byte{iEOF, 0, 0, 0x00, 0x40, 0, 0} // status: SERVER_SESSION_STATE_CHANGED
+	okEOF = appendLengthEncodedString(okEOF, "")
+	okEOF = appendLengthEncodedString(okEOF, string(trackerEntry(sessionTrackSchema, "db")))
+	mc := newMockConnData(mockResponse(1,
+		[]byte{1}, mockColumn("a", fieldTypeLong, 0, 63),
+		mockTextRow("1"), mockTextRow("2"), okEOF,
+	))
+	mc.flags = clientProtocol41 | clientDeprecateEOF | clientSessionTrack
+
+	rows, err := mc.query("SELECT a FROM t", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rows.Next(make([]driver.Value, 1)); err != nil {
+		t.Fatal(err)
+	}
+	// the OK packet read while draining the rows reports the state
+	if err := rows.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if schema := mc.SessionState().Schema; schema != "db" {
+		t.Errorf("SessionState().Schema = %q, want %q", schema, "db")
+	}
+}
@@ -1,7 +1,9 @@
 package mysqldriver
 
 import (
+	"context"
 	"database/sql/driver"
+	"errors"
 	"io"
 )
 
@@ -9,12 +11,22 @@ type mysqlStmt struct {
 	mc         *mysqlConn
 	id         uint32
 	paramCount int
+	sql        string
+
+	// set while the statement is owned by the connection's stmtCache
+	cache *stmtCache
+	inUse bool
 }
 
 func (stmt *mysqlStmt) Close() error {
 	if stmt.mc == nil || stmt.mc.closed.Load() {
 		return nil
 	}
+	if stmt.cache != nil {
+		// return the statement to the cache, it stays prepared on the server
+		stmt.inUse = false
+		return nil
+	}
 	err := stmt.mc.writeCommandPacketUint32(comStmtClose, stmt.id)
 	stmt.mc = nil
 	return err
@@ -22,12 +34,23 @@ func (stmt *mysqlStmt) Close() error {
 func (stmt *mysqlStmt) NumInput() int {
 	return stmt.paramCount
 }
+
+func (stmt *mysqlStmt) CheckNamedValue(nv *driver.NamedValue) error {
+	return stmt.mc.CheckNamedValue(nv)
+}
+
 func (stmt *mysqlStmt) Exec(args []driver.Value) (driver.Result, error) {
+	return stmt.exec(args, stmt.mc.cfg.FetchWarnings)
+}
+
+// exec executes the statement and, if fetchWarnings is set, reads the
+// warnings it produced.
+func (stmt *mysqlStmt) exec(args []driver.Value, fetchWarnings bool) (driver.Result, error) {
 	if stmt.mc.closed.Load() {
 		return nil, driver.ErrBadConn
 	}
 
-	err := stmt.writeExecutePacket(args)
+	err := stmt.writeExecutePacket(args, cursorTypeNoCursor)
 	if err != nil {
 		return nil, stmt.mc.markBadConn(err)
 	}
@@ -37,12 +60,13 @@ func (stmt *mysqlStmt) Exec(args []driver.Value) (driver.Result, error) {
 
 	resLen, err := handleOk.readResultSetHeaderPacket()
 	if err != nil {
+		stmt.checkReprepare(err)
 		return nil, err
 	}
 
 	if resLen > 0 {
 		// columns
-		if err := mc.readUntilEOF(); err != nil {
+		if err := mc.skipColumns(resLen); err != nil {
 			return nil, err
 		}
 		// rows
@@ -56,18 +80,61 @@ func (stmt *mysqlStmt) Exec(args []driver.Value) (driver.Result, error) {
 	}
 
 	copied := mc.result
-	return &copied, nil
+	return mc.withWarnings(&copied, fetchWarnings)
+}
+
+func (stmt *mysqlStmt) ExecContext(ctx context.Context, args []driver.NamedValue) (driver.Result, error) {
+	dargs, err := namedValueToValue(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := stmt.mc.watchCancel(ctx); err != nil {
+		return nil, err
+	}
+	defer stmt.mc.finish()
+	return stmt.exec(dargs, fetchWarningsFromContext(ctx, stmt.mc.cfg.FetchWarnings))
 }
 
 func (stmt *mysqlStmt) Query(args []driver.Value) (driver.Rows, error) {
-	return stmt.query(args)
+	rows, err := stmt.query(args, stmt.mc.cfg.FetchSize)
+	if err != nil {
+		return nil, err
+	}
+	rows.fetchWarnings = stmt.mc.cfg.FetchWarnings
+	return rows, nil
 }
-func (stmt *mysqlStmt) query(args []driver.Value) (*binaryRows, error) {
+
+func (stmt *mysqlStmt) QueryContext(ctx context.Context, args []driver.NamedValue) (driver.Rows, error) {
+	dargs, err := namedValueToValue(args)
+	if err != nil {
+		return nil, err
+	}
+	if err := stmt.mc.watchCancel(ctx); err != nil {
+		return nil, err
+	}
+	rows, err := stmt.query(dargs, fetchSizeFromContext(ctx, stmt.mc.cfg.FetchSize))
+	if err != nil {
+		stmt.mc.finish()
+		return nil, err
+	}
+	rows.finish = stmt.mc.finish
+	rows.fetchWarnings = fetchWarningsFromContext(ctx, stmt.mc.cfg.FetchWarnings)
+	return rows, err
+}
+
+// query executes the statement. If fetchSize is positive, the rows are read
+// through a read-only server-side cursor, fetchSize rows at a time.
+func (stmt *mysqlStmt) query(args []driver.Value, fetchSize int) (*binaryRows, error) {
 	if stmt.mc.closed.Load() {
 		return nil, driver.ErrBadConn
 	}
 
-	err := stmt.writeExecutePacket(args)
+	cursorType := cursorTypeNoCursor
+	if fetchSize > 0 {
+		cursorType = cursorTypeReadOnly
+	}
+
+	err := stmt.writeExecutePacket(args, cursorType)
 	if err != nil {
 		return nil, stmt.mc.markBadConn(err)
 	}
@@ -76,6 +143,7 @@ func (stmt *mysqlStmt) query(args []driver.Value) (*binaryRows, error) {
 	handleOk := stmt.mc.clearResult()
 	resLen, err := handleOk.readResultSetHeaderPacket()
 	if err != nil {
+		stmt.checkReprepare(err)
 		return nil, err
 	}
 
@@ -84,6 +152,9 @@ func (stmt *mysqlStmt) query(args []driver.Value) (*binaryRows, error) {
 	if resLen > 0 {
 		rows.mc = mc
 		rows.rs.columns, err = mc.readColumns(resLen)
+		if err == nil && cursorType == cursorTypeReadOnly {
+			err = rows.openCursor(stmt.id, fetchSize)
+		}
 	} else {
 		rows.rs.done = true
 
@@ -98,6 +169,32 @@ func (stmt *mysqlStmt) query(args []driver.Value) (*binaryRows, error) {
 	return rows, err
 }
 
+// checkReprepare drops a cached statement from the cache if the server
+// reported that it has to be prepared again, e.g. because a table it reads
+// from was altered.
+func (stmt *mysqlStmt) checkReprepare(err error) {
+	if errors.Is(err, ErNeedReprepare) && stmt.cache != nil {
+		stmt.cache.remove(stmt)
+	}
+}
+
+type fetchSizeKey struct{}
+
+// WithFetchSize returns a copy of ctx which makes queries of prepared
+// statements read their rows through a server-side cursor, n rows per
+// COM_STMT_FETCH. It overrides the fetchSize DSN parameter; n = 0 disables
+// the cursor. Queries using the text protocol are not affected.
+func WithFetchSize(ctx context.Context, n int) context.Context {
+	return context.WithValue(ctx, fetchSizeKey{}, n)
+}
+
+func fetchSizeFromContext(ctx context.Context, defaultSize int) int {
+	if n, ok := ctx.Value(fetchSizeKey{}).(int); ok {
+		return n
+	}
+	return defaultSize
+}
+
 type converter struct{}
 
 func (c converter) ConvertValue(v any) (driver.Value, error) {
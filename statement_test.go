@@ -0,0 +1,51 @@
+package mysqldriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"strings"
+	"testing"
+)
+
+func TestStmtExecContext(t *testing.T) {
+	// OK packet answering COM_STMT_EXECUTE: 1 affected row, 1 warning
+	mc := newMockConnData(mockResponse(1, []byte{iOK, 0x01, 0x00, 0x02, 0x00, 0x01, 0x00}))
+	stmt := &mysqlStmt{mc: mc, id: 1}
+
+	ctx, cancel := context.WithCancel(WithFetchWarnings(context.Background(), false))
+	defer cancel()
+	res, err := stmt.ExecContext(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := res.RowsAffected(); n != 1 {
+		t.Errorf("RowsAffected() = %d, want 1", n)
+	}
+	if w := res.(Result).WarningCount(); w != 1 {
+		t.Errorf("WarningCount() = %d, want 1", w)
+	}
+	if mc.watching {
+		t.Error("ExecContext did not finish watching the context")
+	}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := stmt.ExecContext(canceled, nil); err != context.Canceled {
+		t.Errorf("canceled context: got %v, want context.Canceled", err)
+	}
+}
+
+func TestStmtCheckNamedValue(t *testing.T) {
+	stmt := &mysqlStmt{mc: newMockConn(nil)}
+	var _ driver.NamedValueChecker = stmt
+
+	r := strings.NewReader("data")
+	nv := &driver.NamedValue{Ordinal: 1, Value: r}
+	if err := stmt.CheckNamedValue(nv); err != nil || nv.Value != r {
+		t.Errorf("io.Reader: got %v, %v", nv.Value, err)
+	}
+	nv = &driver.NamedValue{Ordinal: 1, Value: int64(42)}
+	if err := stmt.CheckNamedValue(nv); err != nil || nv.Value != int64(42) {
+		t.Errorf("int64: got %v, %v", nv.Value, err)
+	}
+}
@@ -0,0 +1,97 @@
+package mysqldriver
+
+import "container/list"
+
+// StmtCacheStats holds the counters of the prepared statement cache of a
+// connection.
+type StmtCacheStats struct {
+	Size      int    // number of cached statements
+	Hits      uint64 // Prepare calls served from the cache
+	Misses    uint64 // Prepare calls sent to the server
+	Evictions uint64 // statements closed to make room or after ER_NEED_REPREPARE
+}
+
+// StmtCacheStatsProvider is implemented by connections of this driver. Use
+// sql.Conn.Raw to access it.
+type StmtCacheStatsProvider interface {
+	// StmtCacheStats returns the counters of the prepared statement cache.
+	// All counters are zero if the cache is disabled (stmtCacheSize=0).
+	StmtCacheStats() StmtCacheStats
+}
+
+func (mc *mysqlConn) StmtCacheStats() StmtCacheStats {
+	if mc.stmtCache == nil {
+		return StmtCacheStats{}
+	}
+	stats := mc.stmtCache.stats
+	stats.Size = mc.stmtCache.lru.Len()
+	return stats
+}
+
+// stmtCache is a LRU cache of prepared statements keyed by their query.
+// A cached statement is lent to one user at a time; Close returns it to the
+// cache instead of closing it on the server.
+type stmtCache struct {
+	size  int
+	lru   *list.List // of *mysqlStmt, most recently used first
+	items map[string]*list.Element
+	stats StmtCacheStats
+}
+
+func newStmtCache(size int) *stmtCache {
+	return &stmtCache{
+		size:  size,
+		lru:   list.New(),
+		items: make(map[string]*list.Element, size),
+	}
+}
+
+// get returns the cached statement for query, or nil if there is none or it
+// is already in use.
+func (c *stmtCache) get(query string) *mysqlStmt {
+	elem, ok := c.items[query]
+	if !ok || elem.Value.(*mysqlStmt).inUse {
+		c.stats.Misses++
+		return nil
+	}
+	c.stats.Hits++
+	c.lru.MoveToFront(elem)
+	stmt := elem.Value.(*mysqlStmt)
+	stmt.inUse = true
+	return stmt
+}
+
+// put adds a newly prepared statement to the cache, evicting the least
+// recently used one if the cache is full. It reports whether stmt was cached.
+func (c *stmtCache) put(stmt *mysqlStmt) bool {
+	if _, ok := c.items[stmt.sql]; ok {
+		return false
+	}
+	if c.lru.Len() >= c.size {
+		if err := c.remove(c.lru.Back().Value.(*mysqlStmt)); err != nil {
+			return false
+		}
+	}
+	stmt.cache = c
+	stmt.inUse = true
+	c.items[stmt.sql] = c.lru.PushFront(stmt)
+	return true
+}
+
+// remove drops stmt from the cache. It is closed on the server right away,
+// or by its user's Close if it is in use.
+func (c *stmtCache) remove(stmt *mysqlStmt) error {
+	elem, ok := c.items[stmt.sql]
+	if !ok || elem.Value != stmt {
+		return nil
+	}
+	c.lru.Remove(elem)
+	delete(c.items, stmt.sql)
+	c.stats.Evictions++
+
+	stmt.cache = nil
+	if stmt.inUse {
+		return nil
+	}
+	return stmt.Close()
+}
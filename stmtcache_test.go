@@ -0,0 +1,98 @@
+package mysqldriver
+
+import (
+	"database/sql/driver"
+	"errors"
+	"testing"
+)
+
+// mockPrepareOK is the response to COM_STMT_PREPARE for a statement without
+// parameters or columns.
+func mockPrepareOK(id byte) []byte {
+	return mockResponse(1, []byte{iOK, id, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0})
+}
+
+func testPrepare(query string) []byte {
+	return append([]byte{comStmtPrepare}, query...)
+}
+
+func testClose(id byte) []byte {
+	return []byte{comStmtClose, id, 0, 0, 0}
+}
+
+func mustPrepare(t *testing.T, mc *mysqlConn, query string) *mysqlStmt {
+	t.Helper()
+	stmt, err := mc.Prepare(query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stmt.(*mysqlStmt)
+}
+
+func TestStmtCacheLRU(t *testing.T) {
+	mc := newMockConnData(mockPrepareOK(1), mockPrepareOK(2), mockPrepareOK(3))
+	mc.stmtCache = newStmtCache(2)
+
+	s1 := mustPrepare(t, mc, "q1")
+	s1.Close()
+	mustPrepare(t, mc, "q2").Close()
+	// q1 becomes the most recently used statement
+	if stmt := mustPrepare(t, mc, "q1"); stmt != s1 {
+		t.Fatal("q1 was prepared again")
+	}
+	s1.Close()
+	// so q2 is evicted to make room for q3
+	mustPrepare(t, mc, "q3").Close()
+
+	wantCommands(t, mc, testPrepare("q1"), testPrepare("q2"), testPrepare("q3"), testClose(2))
+	want := StmtCacheStats{Size: 2, Hits: 1, Misses: 3, Evictions: 1}
+	if got := mc.StmtCacheStats(); got != want {
+		t.Errorf("StmtCacheStats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestStmtCacheInUse(t *testing.T) {
+	mc := newMockConnData(mockPrepareOK(1), mockPrepareOK(2), mockPrepareOK(3))
+	mc.stmtCache = newStmtCache(1)
+
+	s1 := mustPrepare(t, mc, "q1")
+	// a statement in use isn't lent twice; the copy isn't cached
+	s2 := mustPrepare(t, mc, "q1")
+	if s2 == s1 {
+		t.Fatal("statement in use returned from the cache")
+	}
+	s2.Close()
+
+	// evicting a statement in use leaves closing it to its user
+	s3 := mustPrepare(t, mc, "q3")
+	wantCommands(t, mc, testPrepare("q1"), testPrepare("q1"), testClose(2), testPrepare("q3"))
+	s1.Close()
+	s3.Close()
+	wantCommands(t, mc, testPrepare("q1"), testPrepare("q1"), testClose(2), testPrepare("q3"), testClose(1))
+}
+
+func TestStmtCacheReprepare(t *testing.T) {
+	errPkt := append([]byte{iERR, 0x4f, 0x06, '#', 'H', 'Y', '0', '0', '0'}, "Prepared statement needs to be re-prepared"...)
+	mc := newMockConnData(mockPrepareOK(1), mockResponse(1, errPkt), mockPrepareOK(2))
+	mc.stmtCache = newStmtCache(2)
+
+	s1 := mustPrepare(t, mc, "q1")
+	if _, err := s1.Exec([]driver.Value{}); !errors.Is(err, ErNeedReprepare) {
+		t.Fatalf("got %v, want ErNeedReprepare", err)
+	}
+	s1.Close()
+	// the statement is prepared again instead of being taken from the cache
+	if stmt := mustPrepare(t, mc, "q1"); stmt == s1 || stmt.id != 2 {
+		t.Fatalf("got statement %d, want a new one", stmt.id)
+	}
+
+	wantCommands(t, mc,
+		testPrepare("q1"),
+		[]byte{comStmtExecute, 1, 0, 0, 0, cursorTypeNoCursor, 1, 0, 0, 0},
+		testClose(1),
+		testPrepare("q1"),
+	)
+	if got := mc.StmtCacheStats(); got.Size != 1 || got.Evictions != 1 {
+		t.Errorf("StmtCacheStats() = %+v, want 1 statement and 1 eviction", got)
+	}
+}
@@ -9,4 +9,7 @@ require (
 	github.com/go-sql-driver/mysql v1.9.2
 )
 
-require filippo.io/edwards25519 v1.1.0 // indirect
+require (
+	filippo.io/edwards25519 v1.1.0 // indirect
+	golang.org/x/text v0.30.0 // indirect
+)
@@ -12,4 +12,5 @@ require (
 require (
 	filippo.io/edwards25519 v1.1.0 // indirect
 	github.com/go-sql-driver/mysql v1.9.2 // indirect
+	golang.org/x/text v0.30.0 // indirect
 )
@@ -4,7 +4,6 @@ import (
 	"database/sql/driver"
 	"encoding/binary"
 	"errors"
-	"io"
 	"sync/atomic"
 )
 
@@ -39,7 +38,9 @@ func appendLengthEncodedString(b []byte, s string) []byte {
 	return append(b, s...)
 }
 
-// returns the number read, whether the value is NULL and the number of bytes read
+// returns the number read, whether the value is NULL and the number of bytes read.
+// If b is too short to hold the integer, the number of bytes read is larger
+// than len(b) and the value is 0.
 func readLengthEncodedInteger(b []byte) (uint64, bool, int) {
 	// See issue #349
 	if len(b) == 0 {
@@ -53,14 +54,23 @@ func readLengthEncodedInteger(b []byte) (uint64, bool, int) {
 
 	// 252: value of following 2
 	case 0xfc:
+		if len(b) < 3 {
+			return 0, false, 3
+		}
 		return uint64(binary.LittleEndian.Uint16(b[1:])), false, 3
 
 	// 253: value of following 3
 	case 0xfd:
+		if len(b) < 4 {
+			return 0, false, 4
+		}
 		return uint64(getUint24(b[1:])), false, 4
 
 	// 254: value of following 8
 	case 0xfe:
+		if len(b) < 9 {
+			return 0, false, 9
+		}
 		return uint64(binary.LittleEndian.Uint64(b[1:])), false, 9
 	}
 
@@ -91,9 +101,15 @@ func namedValueToValue(named []driver.NamedValue) ([]driver.Value, error) {
 	}
 	return dargs, nil
 }
+
+// readLengthEncodedString returns ErrMalformPkt if b ends before the length
+// or the string.
 func readLengthEncodedString(b []byte) ([]byte, bool, int, error) {
 	// Get length
 	num, isNull, n := readLengthEncodedInteger(b)
+	if n > len(b) {
+		return nil, false, n, ErrMalformPkt
+	}
 	if num < 1 {
 		return b[n:n], isNull, n, nil
 	}
@@ -104,11 +120,14 @@ func readLengthEncodedString(b []byte) ([]byte, bool, int, error) {
 	if len(b) >= n {
 		return b[n-int(num) : n : n], false, n, nil
 	}
-	return nil, false, n, io.EOF
+	return nil, false, n, ErrMalformPkt
 }
 func skipLengthEncodedString(b []byte) (int, error) {
 	// Get length
 	num, _, n := readLengthEncodedInteger(b)
+	if n > len(b) {
+		return n, ErrMalformPkt
+	}
 	if num < 1 {
 		return n, nil
 	}
@@ -119,7 +138,7 @@ func skipLengthEncodedString(b []byte) (int, error) {
 	if len(b) >= n {
 		return n, nil
 	}
-	return n, io.EOF
+	return n, ErrMalformPkt
 }
 
 // noCopy may be added to structs which must not be copied
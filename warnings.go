@@ -0,0 +1,149 @@
+package mysqldriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// MySQLWarning is a note, warning or error reported by SHOW WARNINGS.
+type MySQLWarning struct {
+	Level   string // "Note", "Warning" or "Error"
+	Code    uint16
+	Message string
+}
+
+// MySQLWarnings are the warnings of a statement, read with SHOW WARNINGS if
+// fetching them is enabled with the fetchWarnings DSN parameter or
+// WithFetchWarnings.
+//
+// Queries return all rows before reporting the warnings as error from
+// rows.Err; use errors.As to tell them apart from failures. Exec doesn't fail
+// because of warnings, as database/sql would discard its result. It attaches
+// them to the result instead, where Result.Warnings returns them if Exec is
+// called through sql.Conn.Raw.
+//
+// SHOW WARNINGS only reports the warnings of the last statement. Those of
+// the earlier statements or result sets of a multi-statement query are lost,
+// although RowsWarningCount still reports how many each result set had.
+type MySQLWarnings []MySQLWarning
+
+func (mws MySQLWarnings) Error() string {
+	var sb strings.Builder
+	for i, w := range mws {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		fmt.Fprintf(&sb, "%s %d: %s", w.Level, w.Code, w.Message)
+	}
+	return sb.String()
+}
+
+// RowsWarningCount is implemented by the driver.Rows returned from this
+// driver (e.g. via sql.Conn.Raw).
+type RowsWarningCount interface {
+	driver.Rows
+	// WarningCount returns the number of warnings of the current result
+	// set. It is only known after all its rows have been read.
+	WarningCount() uint16
+}
+
+func (rows *mysqlRows) WarningCount() uint16 {
+	return rows.rs.warnings
+}
+
+// endResultSet marks the current result set as done after its EOF packet
+// was read and returns the error Next reports for it: io.EOF or, at the end
+// of the last result set, the warnings if they are fetched.
+func (rows *mysqlRows) endResultSet() error {
+	mc := rows.mc
+	rows.rs.done = true
+	rows.rs.warnings = mc.result.WarningCount()
+	if rows.HasNextResultSet() {
+		return io.EOF
+	}
+	rows.mc = nil
+
+	if rows.fetchWarnings && rows.rs.warnings > 0 {
+		warnings, err := mc.getWarnings()
+		if err != nil {
+			return err
+		}
+		return warnings
+	}
+	return io.EOF
+}
+
+type fetchWarningsKey struct{}
+
+// WithFetchWarnings returns a copy of ctx which enables or disables fetching
+// the warnings of statements, overriding the fetchWarnings DSN parameter.
+func WithFetchWarnings(ctx context.Context, enabled bool) context.Context {
+	return context.WithValue(ctx, fetchWarningsKey{}, enabled)
+}
+
+func fetchWarningsFromContext(ctx context.Context, defaultEnabled bool) bool {
+	if enabled, ok := ctx.Value(fetchWarningsKey{}).(bool); ok {
+		return enabled
+	}
+	return defaultEnabled
+}
+
+// withWarnings returns res with, if enabled and the statement produced
+// warnings, the warnings attached, see MySQLWarnings.
+func (mc *mysqlConn) withWarnings(res *mysqlResult, enabled bool) (driver.Result, error) {
+	if !enabled || res.WarningCount() == 0 {
+		return res, nil
+	}
+	warnings, err := mc.getWarnings()
+	if err != nil {
+		return nil, err
+	}
+	res.warnings = warnings
+	return res, nil
+}
+
+// getWarnings reads the warnings of the last statement with SHOW WARNINGS.
+func (mc *mysqlConn) getWarnings() (MySQLWarnings, error) {
+	// the session state reported for the statement must survive the query
+	session := mc.session
+	defer func() { mc.session = session }()
+
+	rows, err := mc.query("SHOW WARNINGS", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var warnings MySQLWarnings
+	values := make([]driver.Value, 3)
+	for {
+		err = rows.Next(values)
+		switch err {
+		case nil:
+			code, _ := strconv.ParseUint(warningString(values[1]), 10, 16)
+			warnings = append(warnings, MySQLWarning{
+				Level:   warningString(values[0]),
+				Code:    uint16(code),
+				Message: warningString(values[2]),
+			})
+		case io.EOF:
+			return warnings, nil
+		default:
+			rows.Close()
+			return nil, err
+		}
+	}
+}
+
+func warningString(v driver.Value) string {
+	switch v := v.(type) {
+	case []byte:
+		return string(v)
+	case string:
+		return v
+	}
+	return ""
+}
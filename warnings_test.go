@@ -0,0 +1,85 @@
+package mysqldriver
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+// mockShowWarnings is the response to SHOW WARNINGS reporting a truncation.
+var mockShowWarnings = mockResponse(1,
+	[]byte{3},
+	mockColumn("Level", fieldTypeVarString, 0, 33),
+	mockColumn("Code", fieldTypeLong, flagUnsigned, 63),
+	mockColumn("Message", fieldTypeVarString, 0, 33),
+	mockEOF(0),
+	mockTextRow("Warning", "1265", "Data truncated for column 'name' at row 1"),
+	mockEOF(0),
+)
+
+var wantWarnings = MySQLWarnings{{Level: "Warning", Code: 1265, Message: "Data truncated for column 'name' at row 1"}}
+
+func TestExecFetchWarnings(t *testing.T) {
+	// OK packet: 1 affected row, insert ID 5, 1 warning and the new schema
+	okPkt := []byte{iOK, 1, 5, 0x00, 0x40, 1, 0, 0} // status: SERVER_SESSION_STATE_CHANGED
+	okPkt = appendLengthEncodedString(okPkt, string(appendLengthEncodedString([]byte{sessionTrackSchema}, string(appendLengthEncodedString(nil, "db")))))
+	mc := newMockConnData(mockResponse(1, okPkt), mockShowWarnings)
+	mc.flags = clientProtocol41 | clientSessionTrack
+
+	res, err := mc.ExecContext(WithFetchWarnings(context.Background(), true), "INSERT INTO t VALUES ('too long')", nil)
+	// the warnings don't fail the statement, they are attached to the result
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := res.RowsAffected(); n != 1 {
+		t.Errorf("RowsAffected() = %d, want 1", n)
+	}
+	if id, _ := res.LastInsertId(); id != 5 {
+		t.Errorf("LastInsertId() = %d, want 5", id)
+	}
+	if got := res.(Result).Warnings(); !reflect.DeepEqual(got, wantWarnings) {
+		t.Errorf("Warnings() = %v, want %v", got, wantWarnings)
+	}
+	// SHOW WARNINGS doesn't replace the session state of the statement
+	if schema := mc.SessionState().Schema; schema != "db" {
+		t.Errorf("SessionState().Schema = %q, want %q", schema, "db")
+	}
+}
+
+func TestQueryFetchWarnings(t *testing.T) {
+	eofWithWarning := []byte{iEOF, 1, 0, 0, 0}
+	mc := newMockConnData(mockResponse(1,
+		[]byte{1},
+		mockColumn("name", fieldTypeVarString, 0, 33),
+		mockEOF(0),
+		mockTextRow("a"),
+		eofWithWarning,
+	), mockShowWarnings)
+
+	rows, err := mc.QueryContext(WithFetchWarnings(context.Background(), true), "SELECT name FROM t", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dest := make([]driver.Value, 1)
+	if err := rows.Next(dest); err != nil {
+		t.Fatal(err)
+	}
+	// the warnings are reported after the last row
+	err = rows.Next(dest)
+	var warnings MySQLWarnings
+	if !errors.As(err, &warnings) || !reflect.DeepEqual(warnings, wantWarnings) {
+		t.Errorf("got %v, want %v", err, wantWarnings)
+	}
+	if n := rows.(RowsWarningCount).WarningCount(); n != 1 {
+		t.Errorf("WarningCount() = %d, want 1", n)
+	}
+	if err := rows.Next(dest); err != io.EOF {
+		t.Errorf("after the warnings: got %v, want io.EOF", err)
+	}
+	if err := rows.Close(); err != nil {
+		t.Error(err)
+	}
+}